@@ -1,5 +1,7 @@
 package client
 
+import "github.com/yoRyuuuuu/typex/proto"
+
 type Action interface{}
 
 type Attack struct {
@@ -7,6 +9,15 @@ type Attack struct {
 	Text string
 }
 
+type Progress struct {
+	Action
+	Text string
+}
+
+type Rematch struct {
+	Action
+}
+
 type ModeChange struct {
 	Action
 	Mode
@@ -22,3 +33,27 @@ type Aim struct {
 	Mode
 	Target int
 }
+
+// 攻撃のたびにサーバが攻撃対象を選ぶ
+type Auto struct {
+	Mode
+	Target proto.TargetMode
+}
+
+// サーバが攻撃対象を選ぶコマンドと選び方
+var targetCommands = map[string]proto.TargetMode{
+	"lowest":   proto.TargetMode_LOWEST_HEALTH,
+	"highest":  proto.TargetMode_HIGHEST_HEALTH,
+	"revenge":  proto.TargetMode_REVENGE,
+	"attacker": proto.TargetMode_ATTACKER,
+}
+
+// 攻撃対象の選び方に対応するコマンド名
+func targetCommand(mode proto.TargetMode) string {
+	for command, m := range targetCommands {
+		if m == mode {
+			return command
+		}
+	}
+	return mode.String()
+}
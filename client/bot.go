@@ -0,0 +1,234 @@
+package client
+
+import (
+	"fmt"
+	"log"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/yoRyuuuuu/typex/proto"
+	"github.com/yoRyuuuuu/typex/romaji"
+)
+
+// 1単語とみなす打鍵数
+const keystrokesPerWord = 5
+
+// ボットが攻撃対象を選ぶ方法
+type Strategy int
+
+const (
+	// 攻撃のたびにランダムに選ぶ
+	RandomTarget Strategy = iota
+	// 体力が最も少ないプレイヤーを狙う
+	WeakestTarget
+	// 体力が最も多いプレイヤーを狙う
+	StrongestTarget
+	// 最後に自分にダメージを与えたプレイヤーを狙う
+	RevengeTarget
+	// 自分を狙っているプレイヤーを狙う
+	AttackerTarget
+)
+
+var strategyNames = map[string]Strategy{
+	"random":    RandomTarget,
+	"weakest":   WeakestTarget,
+	"strongest": StrongestTarget,
+	"revenge":   RevengeTarget,
+	"attacker":  AttackerTarget,
+}
+
+func (s Strategy) String() string {
+	for name, strategy := range strategyNames {
+		if strategy == s {
+			return name
+		}
+	}
+	return "unknown"
+}
+
+// 名前から攻撃対象の選び方を返す
+func ParseStrategy(name string) (Strategy, error) {
+	strategy, ok := strategyNames[name]
+	if !ok {
+		return RandomTarget, fmt.Errorf("unknown strategy %v (random, weakest, strongest, revenge, attacker)", name)
+	}
+	return strategy, nil
+}
+
+// お題を自動で入力して攻撃するプレイヤー
+type Bot struct {
+	// 1分あたりの入力単語数(5打鍵を1単語とする)
+	WPM float64
+	// 単語を打ち間違える確率
+	ErrorRate float64
+	Strategy  Strategy
+	// サーバとの接続が切れると閉じる
+	Done chan struct{}
+	// 入力中のお題の番号。新しいお題が届くと増え、古い入力を止める
+	word   int
+	wordMu sync.Mutex
+	*Game
+}
+
+func NewBot(gameClient *GameClient, wpm float64, errorRate float64, strategy Strategy) *Bot {
+	return &Bot{
+		WPM:       wpm,
+		ErrorRate: errorRate,
+		Strategy:  strategy,
+		Done:      make(chan struct{}),
+		word:      0,
+		Game:      NewGame(gameClient),
+	}
+}
+
+func (b *Bot) Start() {
+	go b.watch()
+}
+
+// Eventの処理と送信を1つのgoroutineで行う
+func (b *Bot) watch() {
+	for {
+		select {
+		case event := <-b.EventChannel:
+			b.handleEvent(event)
+			if !b.react(event) {
+				close(b.Done)
+				return
+			}
+		case action := <-b.ActionReceiver:
+			if _, ok := action.(Attack); ok {
+				b.aim()
+			}
+			b.handleAction(action)
+		}
+	}
+}
+
+// Eventに応じて入力を始める。接続が切れた場合はfalseを返す
+func (b *Bot) react(event Event) bool {
+	switch event := event.(type) {
+	case QuestionEvent:
+		b.typeWord(0)
+	case SnapshotEvent:
+		if event.Question != nil {
+			b.typeWord(0)
+		}
+	case AttackResultEvent:
+		// 打ち間違えたら同じお題を入力し直す
+		if !event.Hit {
+			b.typeWord(event.Lockout)
+		}
+	case StartEvent:
+		b.stopTyping()
+	case FinishEvent:
+		b.stopTyping()
+		log.Printf("%v: finish, %v win", b.name(), event.Winner)
+		b.handleRematchAction()
+	case DisconnectEvent:
+		if !event.Reconnecting {
+			b.stopTyping()
+			return false
+		}
+	}
+	return true
+}
+
+func (b *Bot) name() string {
+	b.Mutex.RLock()
+	defer b.Mutex.RUnlock()
+	if status, ok := b.PlayerStatuses[b.MyID]; ok {
+		return status.Name
+	}
+	return b.MyID
+}
+
+// 入力中のお題を止める
+func (b *Bot) stopTyping() int {
+	b.wordMu.Lock()
+	defer b.wordMu.Unlock()
+	b.word++
+	return b.word
+}
+
+func (b *Bot) typing(word int) bool {
+	b.wordMu.Lock()
+	defer b.wordMu.Unlock()
+	return b.word == word
+}
+
+// 現在のお題をdelay後から1打鍵ずつ入力する
+func (b *Bot) typeWord(delay time.Duration) {
+	keys := b.Word
+	if b.Reading != "" {
+		keys = romaji.Romanize(b.Reading)
+	}
+	if keys == "" {
+		return
+	}
+	word := b.stopTyping()
+
+	go func() {
+		time.Sleep(delay)
+		for i := 1; i <= len(keys); i++ {
+			time.Sleep(b.keystrokeInterval())
+			if !b.typing(word) {
+				return
+			}
+			b.ActionReceiver <- Progress{Text: keys[:i]}
+		}
+
+		input := keys
+		if rand.Float64() < b.ErrorRate {
+			input = typo(keys)
+		}
+		if !b.typing(word) {
+			return
+		}
+		b.ActionReceiver <- Attack{Text: input}
+	}()
+}
+
+// 1打鍵にかかる時間。WPMを中心にばらつかせる
+func (b *Bot) keystrokeInterval() time.Duration {
+	interval := float64(time.Minute) / (b.WPM * keystrokesPerWord)
+	return time.Duration(interval * (0.5 + rand.Float64()))
+}
+
+// 1文字を別の文字に置き換える
+func typo(keys string) string {
+	i := rand.Intn(len(keys))
+	c := byte('a' + rand.Intn(26))
+	if c == keys[i] {
+		c = 'a' + (c-'a'+1)%26
+	}
+	return keys[:i] + string(c) + keys[i+1:]
+}
+
+// 攻撃の直前に戦略に従って攻撃対象を選ぶ
+// ランダム以外はサーバが攻撃時に最新の体力や攻撃履歴から選ぶ
+func (b *Bot) aim() {
+	switch b.Strategy {
+	case WeakestTarget:
+		b.handleModeChangeAction(ModeChange{Mode: Auto{Target: proto.TargetMode_LOWEST_HEALTH}})
+		return
+	case StrongestTarget:
+		b.handleModeChangeAction(ModeChange{Mode: Auto{Target: proto.TargetMode_HIGHEST_HEALTH}})
+		return
+	case RevengeTarget:
+		b.handleModeChangeAction(ModeChange{Mode: Auto{Target: proto.TargetMode_REVENGE}})
+		return
+	case AttackerTarget:
+		b.handleModeChangeAction(ModeChange{Mode: Auto{Target: proto.TargetMode_ATTACKER}})
+		return
+	}
+
+	b.Mutex.Lock()
+	defer b.Mutex.Unlock()
+	alive := b.aliveEnemyIDs()
+	if len(alive) == 0 {
+		b.Target = ""
+		return
+	}
+	b.Target = alive[rand.Intn(len(alive))]
+}
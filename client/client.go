@@ -2,21 +2,54 @@ package client
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"math/rand"
+	"sync"
 	"time"
 
 	"github.com/yoRyuuuuu/typex/proto"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
+// このクライアントが対応している機能
+var capabilities = []string{
+	proto.PowerUpCapability,
+	proto.ComboCapability,
+	proto.ClockCapability,
+}
+
+// サーバへ知らせるクライアントのバージョンと機能
+func clientInfo() *proto.ClientInfo {
+	return &proto.ClientInfo{
+		ProtocolVersion: proto.ProtocolVersion,
+		Version:         proto.Version,
+		Capability:      capabilities,
+	}
+}
+
+// 接続が切れてから再接続を諦めるまでの時間
+var ReconnectTimeout = 30 * time.Second
+
+// 再接続を試みる間隔
+var reconnectInterval = 1 * time.Second
+
 type GameClient struct {
 	Stream       proto.Game_StreamClient
 	EventChannel chan Event
+	grpcClient   proto.GameClient
+	// Connectで受け取ったトークン。再接続にも使う
+	token string
+	// 接続が切れた時刻。接続中はゼロ値
+	lostAt time.Time
+	mu     sync.RWMutex
+	// ストリームへの送信は1つのgoroutineからのみ行える
+	sendMu sync.Mutex
 }
 
 func NewGameClient() *GameClient {
@@ -26,6 +59,49 @@ func NewGameClient() *GameClient {
 	}
 }
 
+func (c *GameClient) stream() proto.Game_StreamClient {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.Stream
+}
+
+// トークンを付けてストリームを開く
+func (c *GameClient) openStream() error {
+	header := metadata.New(map[string]string{"authorization": c.token})
+	ctx := metadata.NewOutgoingContext(context.Background(), header)
+	stream, err := c.grpcClient.Stream(ctx)
+	if err != nil {
+		return err
+	}
+	c.mu.Lock()
+	c.Stream = stream
+	c.mu.Unlock()
+	return nil
+}
+
+// 接続が切れた場合は同じトークンでストリームを開き直す
+// 再接続を諦めた場合はfalseを返す
+func (c *GameClient) reconnect() bool {
+	if c.grpcClient == nil {
+		return false
+	}
+	if c.lostAt.IsZero() {
+		c.lostAt = time.Now()
+		c.EventChannel <- DisconnectEvent{Reconnecting: true}
+	}
+
+	for time.Since(c.lostAt) < ReconnectTimeout {
+		time.Sleep(reconnectInterval)
+		if err := c.openStream(); err != nil {
+			log.Printf("can not reconnect %v\n", err)
+			continue
+		}
+		return true
+	}
+	c.EventChannel <- DisconnectEvent{Reconnecting: false}
+	return false
+}
+
 func (c *GameClient) Start() {
 	go c.watchEvent()
 }
@@ -33,61 +109,290 @@ func (c *GameClient) Start() {
 // サーバからのEvent通知を捌く
 func (c *GameClient) watchEvent() {
 	for {
-		res, err := c.Stream.Recv()
+		res, err := c.stream().Recv()
 		if err != nil {
-			log.Printf("can not receive %v\n", err)
-			return
+			log.Printf("can not receive %v\n", DescribeError(err))
+			if !c.reconnect() {
+				return
+			}
+			continue
+		}
+		if !c.lostAt.IsZero() {
+			c.lostAt = time.Time{}
+			c.EventChannel <- ReconnectEvent{}
 		}
+
 		switch res.GetEvent().(type) {
 		case *proto.Response_Question: // お題通知
-			c.EventChannel <- QuestionEvent{
-				Text: res.GetQuestion().GetText(),
-			}
+			c.EventChannel <- questionEvent(res.GetQuestion())
 		case *proto.Response_Start: // ゲーム開始通知
-			c.EventChannel <- StartEvent{}
+			players := []PlayerStatus{}
+			for _, player := range res.GetStart().GetPlayer() {
+				players = append(players, PlayerStatus{
+					ID:     player.Id,
+					Name:   player.Name,
+					Health: int(player.Health),
+					Bot:    player.Bot,
+					Team:   int(player.Team),
+					Shield: int(player.Shield),
+					Combo:  int(player.Combo),
+					Target: player.Target,
+				})
+			}
+			c.EventChannel <- StartEvent{
+				Players:   players,
+				Countdown: time.Duration(res.GetStart().GetCountdown()) * time.Millisecond,
+			}
 		case *proto.Response_Finish: // ゲーム終了通知
+			results := []PlayerResult{}
+			for _, result := range res.GetFinish().GetResult() {
+				results = append(results, PlayerResult{
+					ID:          result.Id,
+					Name:        result.Name,
+					Rank:        int(result.Rank),
+					Hit:         int(result.Hit),
+					Miss:        int(result.Miss),
+					DamageDealt: int(result.DamageDealt),
+					DamageTaken: int(result.DamageTaken),
+					WPM:         result.Wpm,
+					Survived:    time.Duration(result.Survived) * time.Millisecond,
+				})
+			}
 			c.EventChannel <- FinishEvent{
-				Winner: res.GetFinish().GetWinner(),
+				Winner:  res.GetFinish().GetWinner(),
+				Results: results,
+			}
+		case *proto.Response_AttackResult: // 攻撃結果通知
+			c.EventChannel <- AttackResultEvent{
+				Hit:     res.GetAttackResult().GetHit(),
+				Penalty: res.GetAttackResult().GetPenalty(),
+				Lockout: time.Duration(res.GetAttackResult().GetLockout()) * time.Millisecond,
 			}
 		case *proto.Response_Join: // 参加通知
 			c.EventChannel <- JoinEvent{
 				ID:     res.GetJoin().GetPlayer().Id,
 				Name:   res.GetJoin().GetPlayer().Name,
 				Health: int(res.GetJoin().GetPlayer().Health),
+				Bot:    res.GetJoin().GetPlayer().Bot,
+				Team:   int(res.GetJoin().GetPlayer().Team),
 			}
 		case *proto.Response_Damage: // ダメージ通知
 			c.EventChannel <- DamageEvent{
 				ID:     res.GetDamage().GetId(),
 				Damage: int(res.GetDamage().GetHealth()),
+				Amount: int(res.GetDamage().GetAmount()),
+			}
+		case *proto.Response_Heal: // 回復通知
+			c.EventChannel <- HealEvent{
+				ID:     res.GetHeal().GetId(),
+				Health: int(res.GetHeal().GetHealth()),
+				Amount: int(res.GetHeal().GetAmount()),
+			}
+		case *proto.Response_Shield: // シールド通知
+			c.EventChannel <- ShieldEvent{
+				ID:     res.GetShield().GetId(),
+				Shield: int(res.GetShield().GetShield()),
+			}
+		case *proto.Response_Error: // エラー通知
+			c.EventChannel <- ErrorEvent{
+				Code:    res.GetError().GetCode().String(),
+				Message: res.GetError().GetMessage(),
+			}
+		case *proto.Response_TargetChanged: // 攻撃対象の変更通知
+			c.EventChannel <- TargetChangedEvent{
+				ID:     res.GetTargetChanged().GetId(),
+				Target: res.GetTargetChanged().GetTargetId(),
+			}
+		case *proto.Response_Clock: // 制限時間通知
+			c.EventChannel <- clockEvent(res.GetClock())
+		case *proto.Response_Combo: // コンボ通知
+			c.EventChannel <- ComboEvent{
+				ID:         res.GetCombo().GetId(),
+				Combo:      int(res.GetCombo().GetCombo()),
+				Multiplier: int(res.GetCombo().GetMultiplier()),
+			}
+		case *proto.Response_Progress: // 入力の進捗通知
+			c.EventChannel <- ProgressEvent{
+				ID:      res.GetProgress().GetId(),
+				Percent: int(res.GetProgress().GetPercent()),
+			}
+		case *proto.Response_Eliminated: // 脱落通知
+			c.EventChannel <- EliminatedEvent{
+				ID: res.GetEliminated().GetId(),
+			}
+		case *proto.Response_Leave: // 退出通知
+			c.EventChannel <- LeaveEvent{
+				ID: res.GetLeave().GetId(),
+			}
+		case *proto.Response_State: // 状態遷移通知
+			c.EventChannel <- StateEvent{
+				Phase: res.GetState().GetPhase().String(),
+			}
+		case *proto.Response_Snapshot: // 再接続時の状態通知
+			players := []PlayerStatus{}
+			for _, player := range res.GetSnapshot().GetPlayer() {
+				players = append(players, PlayerStatus{
+					ID:     player.Id,
+					Name:   player.Name,
+					Health: int(player.Health),
+					Bot:    player.Bot,
+					Team:   int(player.Team),
+					Shield: int(player.Shield),
+					Combo:  int(player.Combo),
+					Target: player.Target,
+				})
+			}
+			words := []QuestionEvent{}
+			for _, word := range res.GetSnapshot().GetWord() {
+				words = append(words, questionEvent(word))
+			}
+			snapshot := SnapshotEvent{
+				Phase:   res.GetSnapshot().GetPhase().String(),
+				Players: players,
+				Words:   words,
+			}
+			if question := res.GetSnapshot().GetQuestion(); question != nil {
+				event := questionEvent(question)
+				snapshot.Question = &event
+			}
+			if clock := res.GetSnapshot().GetClock(); clock != nil {
+				event := clockEvent(clock)
+				snapshot.Clock = &event
+			}
+			c.EventChannel <- snapshot
+		case *proto.Response_RematchVote: // 再戦投票通知
+			c.EventChannel <- RematchVoteEvent{
+				Vote:     int(res.GetRematchVote().GetVote()),
+				Required: int(res.GetRematchVote().GetRequired()),
 			}
 		}
 	}
 }
 
-func (c *GameClient) handleAttackAction(text string, target string) {
+// RPCのエラーの内容と種類
+// サーバがエラーの種類を付けていればそれを、なければgRPCのステータスコードを使う
+func DescribeError(err error) string {
+	st := status.Convert(err)
+	for _, detail := range st.Details() {
+		if e, ok := detail.(*proto.Error); ok {
+			return fmt.Sprintf("%v (%v)", e.GetMessage(), e.GetCode())
+		}
+	}
+	return fmt.Sprintf("%v (%v)", st.Message(), st.Code())
+}
+
+func questionEvent(question *proto.Question) QuestionEvent {
+	return QuestionEvent{
+		ID:      question.GetId(),
+		Text:    question.GetText(),
+		Reading: question.GetReading(),
+		Damage:  int(question.GetDamage()),
+		Effect:  question.GetEffect().String(),
+	}
+}
+
+func clockEvent(clock *proto.Clock) ClockEvent {
+	return ClockEvent{
+		Remaining:   time.Duration(clock.GetRemaining()) * time.Millisecond,
+		SuddenDeath: clock.GetSuddenDeath(),
+		Multiplier:  int(clock.GetMultiplier()),
+	}
+}
+
+func (c *GameClient) send(req *proto.Request) {
+	c.sendMu.Lock()
+	defer c.sendMu.Unlock()
+	if err := c.stream().Send(req); err != nil {
+		log.Printf("can not send %v\n", err)
+	}
+}
+
+func (c *GameClient) handleAttackAction(text string, target string, mode proto.TargetMode) {
 	req := &proto.Request{
 		Action: &proto.Request_Attack{
-			Attack: &proto.Attack{Text: text, TargetId: target}},
+			Attack: &proto.Attack{Text: text, TargetId: target, Mode: mode}},
 	}
-	if err := c.Stream.Send(req); err != nil {
-		log.Printf("can not send %v\n", err)
-		return
+	c.send(req)
+}
+
+func (c *GameClient) handleAimAction(target string, mode proto.TargetMode) {
+	req := &proto.Request{
+		Action: &proto.Request_Aim{
+			Aim: &proto.Aim{TargetId: target, Mode: mode}},
+	}
+	c.send(req)
+}
+
+func (c *GameClient) handleProgressAction(text string) {
+	req := &proto.Request{
+		Action: &proto.Request_Progress{
+			Progress: &proto.Progress{Text: text}},
+	}
+	c.send(req)
+}
+
+func (c *GameClient) handleRematchAction() {
+	req := &proto.Request{
+		Action: &proto.Request_Rematch{
+			Rematch: &proto.Rematch{}},
 	}
+	c.send(req)
 }
 
 // サーバ接続処理
-func (g *Game) connect(grpcClient proto.GameClient, name string) (*proto.ConnectResponse, error) {
-	req := proto.ConnectRequest{Name: name}
-	resp, err := grpcClient.Connect(context.Background(), &req)
+// roomIDが空文字列の場合はデフォルトの部屋に参加する
+func (c *GameClient) connect(grpcClient proto.GameClient, name string, roomID string, team int) (*proto.ConnectResponse, error) {
+	var resp *proto.ConnectResponse
+	var err error
+	if roomID == "" {
+		req := proto.ConnectRequest{Name: name, Team: int64(team), Client: clientInfo()}
+		resp, err = grpcClient.Connect(context.Background(), &req)
+	} else {
+		req := proto.JoinRoomRequest{RoomId: roomID, Name: name, Team: int64(team), Client: clientInfo()}
+		resp, err = grpcClient.JoinRoom(context.Background(), &req)
+	}
 	if err != nil {
 		return nil, err
 	}
-	header := metadata.New(map[string]string{"authorization": resp.Id})
-	ctx := metadata.NewOutgoingContext(context.Background(), header)
-	stream, err := grpcClient.Stream(ctx)
+	c.grpcClient = grpcClient
+	c.token = resp.Id
+	if err := c.openStream(); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// 観戦の接続処理
+// roomIDが空文字列の場合はデフォルトの部屋を観戦する
+func (c *GameClient) spectate(grpcClient proto.GameClient, name string, roomID string) (*proto.ConnectResponse, error) {
+	req := proto.SpectateRequest{RoomId: roomID, Name: name, Client: clientInfo()}
+	resp, err := grpcClient.Spectate(context.Background(), &req)
 	if err != nil {
 		return nil, err
 	}
-	g.Stream = stream
+	c.grpcClient = grpcClient
+	c.token = resp.Id
+	if err := c.openStream(); err != nil {
+		return nil, err
+	}
 	return resp, nil
 }
+
+// 部屋一覧の取得
+func ListRooms(grpcClient proto.GameClient) ([]*proto.Room, error) {
+	resp, err := grpcClient.ListRooms(context.Background(), &proto.ListRoomsRequest{})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRoom(), nil
+}
+
+// 部屋の作成
+func CreateRoom(grpcClient proto.GameClient, name string, capacity int, dataset string, teams int) (*proto.Room, error) {
+	req := proto.CreateRoomRequest{Name: name, Capacity: int64(capacity), Dataset: dataset, Teams: int64(teams)}
+	resp, err := grpcClient.CreateRoom(context.Background(), &req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetRoom(), nil
+}
@@ -1,22 +1,75 @@
 package client
 
+import "time"
+
 type Event interface{}
 
 // ゲーム終了Event
 type FinishEvent struct {
 	Event
 	Winner string
+	// 各プレイヤーの成績
+	Results []PlayerResult
+}
+
+type PlayerResult struct {
+	ID   string
+	Name string
+	// 順位
+	Rank int
+	// 入力に成功した回数
+	Hit int
+	// 入力ミスの回数
+	Miss int
+	// 与えたダメージの合計
+	DamageDealt int
+	// 受けたダメージの合計
+	DamageTaken int
+	// 1分あたりの入力単語数
+	WPM float64
+	// 対戦開始から脱落または終了までの時間
+	Survived time.Duration
+}
+
+// 入力の正確さ(%)
+func (r PlayerResult) Accuracy() int {
+	if r.Hit+r.Miss == 0 {
+		return 0
+	}
+	return r.Hit * 100 / (r.Hit + r.Miss)
+}
+
+// 攻撃の結果Event
+type AttackResultEvent struct {
+	Event
+	Hit bool
+	// 入力ミスで受けたペナルティ
+	Penalty string
+	// 入力を受け付けない残り時間
+	Lockout time.Duration
 }
 
 // お題Event
 type QuestionEvent struct {
 	Event
+	// お題を入力するプレイヤーのID。観戦時のみ
+	ID   string
 	Text string
+	// ひらがなの読み。英単語の場合は空
+	Reading string
+	// 入力に成功したときに与えるダメージ。回復とシールドのお題ではその量
+	Damage int
+	// お題の効果(NORMAL, HEAL, SHIELD, DOUBLE_DAMAGE)
+	Effect string
 }
 
 // ゲーム開始Event
 type StartEvent struct {
 	Event
+	// 開始時点のプレイヤー情報
+	Players []PlayerStatus
+	// 最初のお題が出るまでの時間
+	Countdown time.Duration
 }
 
 // ダメージEvent
@@ -24,8 +77,58 @@ type DamageEvent struct {
 	Event
 	// 攻撃を受けるPlayerID
 	ID string
-	// ダメージの数値
+	// 攻撃を受けた後の体力
 	Damage int
+	// 受けたダメージ
+	Amount int
+}
+
+// 回復Event
+type HealEvent struct {
+	Event
+	// 回復したPlayerID
+	ID string
+	// 回復した後の体力
+	Health int
+	// 回復量
+	Amount int
+}
+
+// シールドEvent
+type ShieldEvent struct {
+	Event
+	// シールドが変化したPlayerID
+	ID string
+	// 残っているシールド
+	Shield int
+}
+
+// サーバが操作を受け付けなかったEvent
+type ErrorEvent struct {
+	Event
+	// エラーの種類(GAME_STARTED, INVALID_TARGET, NOT_YOUR_TURN, RATE_LIMITEDなど)
+	Code    string
+	Message string
+}
+
+// 攻撃対象の変更Event
+type TargetChangedEvent struct {
+	Event
+	// 攻撃対象を変えたPlayerID
+	ID string
+	// 新しい攻撃対象のPlayerID。いなくなった場合は空
+	Target string
+}
+
+// コンボEvent
+type ComboEvent struct {
+	Event
+	// コンボが変化したPlayerID
+	ID string
+	// 連続で正解した回数
+	Combo int
+	// ダメージ倍率
+	Multiplier int
 }
 
 // プレイヤー参加Event
@@ -37,4 +140,80 @@ type JoinEvent struct {
 	Name string
 	// 初期体力
 	Health int
+	// サーバが追加したボット
+	Bot bool
+	// 所属するチーム。個人戦では0
+	Team int
+}
+
+// 再戦投票Event
+type RematchVoteEvent struct {
+	Event
+	// 投票済みのプレイヤー数
+	Vote int
+	// 再戦に必要な投票数
+	Required int
+}
+
+// 入力の進捗Event
+type ProgressEvent struct {
+	Event
+	// 入力しているPlayerID
+	ID string
+	// お題の何%まで入力したか
+	Percent int
+}
+
+// 体力が0になったプレイヤーのEvent
+type EliminatedEvent struct {
+	Event
+	ID string
+}
+
+// 切断したプレイヤーのEvent
+type LeaveEvent struct {
+	Event
+	ID string
+}
+
+// 再接続時に受け取る現在の状態Event
+type SnapshotEvent struct {
+	Event
+	Phase   string
+	Players []PlayerStatus
+	// 対戦中でなければnil
+	Question *QuestionEvent
+	// 観戦時の各プレイヤーのお題
+	Words []QuestionEvent
+	// 制限時間がなければnil
+	Clock *ClockEvent
+}
+
+// 制限時間Event
+type ClockEvent struct {
+	Event
+	// 制限時間、またはサドンデスで次に倍率が上がるまでの時間
+	Remaining   time.Duration
+	SuddenDeath bool
+	// サドンデスのダメージ倍率
+	Multiplier int
+}
+
+// サーバに再接続したEvent
+type ReconnectEvent struct {
+	Event
+}
+
+// サーバとの接続が切れたEvent
+type DisconnectEvent struct {
+	Event
+	// 再接続を試みている間はtrue
+	Reconnecting bool
+}
+
+// ゲームの状態遷移Event
+type StateEvent struct {
+	Event
+	// WAITING, COUNTDOWN, PLAYING, FINISHED のいずれか
+	Phase string
 }
@@ -13,15 +13,70 @@ type PlayerStatus struct {
 	ID     string
 	Name   string
 	Health int
+	// 最後に受けたダメージ
+	LastDamage int
+	// 最後に回復した量
+	LastHeal int
+	// 残っているシールド
+	Shield int
+	// 連続で正解した回数とダメージ倍率
+	Combo      int
+	Multiplier int
+	// 狙っているプレイヤーのID
+	Target string
+	// お題の入力の進捗(%)
+	Progress int
+	// 体力が0になり脱落した
+	Eliminated bool
+	// 観戦時に表示するお題
+	Word    string
+	Reading string
+	Effect  string
+	// サーバが追加したボット
+	Bot bool
+	// 所属するチーム。個人戦では0
+	Team int
+}
+
+// サーバから受け取った部屋のゲーム設定
+type Settings struct {
+	ServerVersion string
+	InitialHealth int
+	DamageModel   string
+	// 0なら制限時間なし
+	TimeLimit time.Duration
+	Dataset   string
+}
+
+func (s Settings) String() string {
+	limit := "no time limit"
+	if s.TimeLimit > 0 {
+		limit = fmt.Sprintf("time limit %v", s.TimeLimit)
+	}
+	return fmt.Sprintf("HP %v, %v damage, %v, dataset %v", s.InitialHealth, s.DamageModel, limit, s.Dataset)
 }
 
 type Game struct {
 	ActionReceiver chan Action
 	PlayerStatuses map[string]*PlayerStatus
 	EnemyIDs       []string
+	AllyIDs        []string
 	MyID           string
 	Target         string
+	TargetMode     proto.TargetMode
 	Word           string
+	Reading        string
+	WordDamage     int
+	WordEffect     string
+	Deadline       time.Time
+	StartsAt       time.Time
+	SuddenDeath    bool
+	DamageScale    int
+	Phase          string
+	Results        []PlayerResult
+	Winner         string
+	Spectator      bool
+	Settings       Settings
 	Logger         Logger
 	Mutex          sync.RWMutex
 	*GameClient
@@ -32,9 +87,23 @@ func NewGame(gameClient *GameClient) *Game {
 		ActionReceiver: make(chan Action),
 		PlayerStatuses: make(map[string]*PlayerStatus),
 		EnemyIDs:       []string{},
+		AllyIDs:        []string{},
 		MyID:           "",
 		Target:         "",
+		TargetMode:     proto.TargetMode_DIRECT,
 		Word:           "",
+		Reading:        "",
+		WordDamage:     0,
+		WordEffect:     "",
+		Deadline:       time.Time{},
+		StartsAt:       time.Time{},
+		SuddenDeath:    false,
+		DamageScale:    1,
+		Phase:          "",
+		Results:        nil,
+		Winner:         "",
+		Spectator:      false,
+		Settings:       Settings{},
 		Logger:         *NewLogger(),
 		GameClient:     gameClient,
 	}
@@ -48,68 +117,367 @@ func (g *Game) Start() {
 
 func (g *Game) watchEvent() {
 	for {
-		event := <-g.EventChannel
-		switch event := event.(type) {
-		case FinishEvent:
-			g.handleFinishEvent(event)
-		case QuestionEvent:
-			g.handleQuestionEvent(event)
-		case StartEvent:
-			g.handleStartEvent(event)
-		case JoinEvent:
-			g.handleJoinEvent(event)
-		case DamageEvent:
-			g.handleDamageEvent(event)
-		}
+		g.handleEvent(<-g.EventChannel)
+	}
+}
+
+func (g *Game) handleEvent(event Event) {
+	switch event := event.(type) {
+	case FinishEvent:
+		g.handleFinishEvent(event)
+	case QuestionEvent:
+		g.handleQuestionEvent(event)
+	case StartEvent:
+		g.handleStartEvent(event)
+	case JoinEvent:
+		g.handleJoinEvent(event)
+	case DamageEvent:
+		g.handleDamageEvent(event)
+	case HealEvent:
+		g.handleHealEvent(event)
+	case ShieldEvent:
+		g.handleShieldEvent(event)
+	case ComboEvent:
+		g.handleComboEvent(event)
+	case ClockEvent:
+		g.handleClockEvent(event)
+	case TargetChangedEvent:
+		g.handleTargetChangedEvent(event)
+	case ErrorEvent:
+		g.handleErrorEvent(event)
+	case RematchVoteEvent:
+		g.handleRematchVoteEvent(event)
+	case StateEvent:
+		g.handleStateEvent(event)
+	case ProgressEvent:
+		g.handleProgressEvent(event)
+	case AttackResultEvent:
+		g.handleAttackResultEvent(event)
+	case EliminatedEvent:
+		g.handleEliminatedEvent(event)
+	case LeaveEvent:
+		g.handleLeaveEvent(event)
+	case SnapshotEvent:
+		g.handleSnapshotEvent(event)
+	case DisconnectEvent:
+		g.handleDisconnectEvent(event)
+	case ReconnectEvent:
+		g.handleReconnectEvent(event)
 	}
 }
 
 func (g *Game) watchAction() {
 	for {
-		action := <-g.ActionReceiver
-		switch action := action.(type) {
-		case Attack:
-			g.handleAttackAction(action.Text, g.Target)
-		case ModeChange:
-			g.handleModeChangeAction(action)
-		}
+		g.handleAction(<-g.ActionReceiver)
+	}
+}
+
+func (g *Game) handleAction(action Action) {
+	switch action := action.(type) {
+	case Attack:
+		g.Mutex.RLock()
+		target, mode := g.Target, g.TargetMode
+		g.Mutex.RUnlock()
+		g.handleAttackAction(action.Text, target, mode)
+	case ModeChange:
+		g.handleModeChangeAction(action)
+	case Rematch:
+		g.handleRematchAction()
+	case Progress:
+		g.handleProgressAction(action.Text)
+	}
+}
+
+// teamが0の場合はチームを自動で割り当てる
+func (g *Game) Connect(grpcClient proto.GameClient, name string, roomID string, team int) error {
+	resp, err := g.connect(grpcClient, name, roomID, team)
+	if err != nil {
+		return err
+	}
+	// ゲームが開始済みの場合、サーバの設定によっては観戦者になる
+	if resp.GetSpectator() {
+		g.Spectator = true
+		g.Logger.PutString(fmt.Sprintln("The game has already started. Spectating"))
 	}
+	g.setSettings(resp)
+	g.setPlayers(resp)
+	return nil
 }
 
-func (g *Game) Connect(grpcClient proto.GameClient, name string) error {
-	resp, err := g.connect(grpcClient, name)
+// 観戦者として接続する
+func (g *Game) Spectate(grpcClient proto.GameClient, name string, roomID string) error {
+	resp, err := g.spectate(grpcClient, name, roomID)
 	if err != nil {
 		return err
 	}
+	g.Spectator = true
+	g.setSettings(resp)
+	g.setPlayers(resp)
+	return nil
+}
+
+func (g *Game) setSettings(resp *proto.ConnectResponse) {
+	settings := resp.GetSettings()
+	g.Settings = Settings{
+		ServerVersion: resp.GetServerVersion(),
+		InitialHealth: int(settings.GetInitialHealth()),
+		DamageModel:   settings.GetDamageModel(),
+		TimeLimit:     time.Duration(settings.GetTimeLimit()) * time.Millisecond,
+		Dataset:       settings.GetDataset(),
+	}
+	g.Logger.PutString(fmt.Sprintln("Server", g.Settings.ServerVersion))
+	g.Logger.PutString(fmt.Sprintln(g.Settings))
+}
+
+func (g *Game) setPlayers(resp *proto.ConnectResponse) {
 	g.MyID = resp.Id
+	players := []PlayerStatus{}
 	for _, player := range resp.GetPlayer() {
-		status := &PlayerStatus{
+		players = append(players, PlayerStatus{
 			ID:     player.Id,
 			Name:   player.Name,
 			Health: int(player.Health),
+			Bot:    player.Bot,
+			Team:   int(player.Team),
+		})
+	}
+	g.resetPlayers(players)
+}
+
+// プレイヤー一覧を作り直す。自分のチームを先に決めてから味方と敵に分ける
+func (g *Game) resetPlayers(players []PlayerStatus) {
+	g.PlayerStatuses = make(map[string]*PlayerStatus)
+	g.EnemyIDs = []string{}
+	g.AllyIDs = []string{}
+	for _, player := range players {
+		if player.ID == g.MyID {
+			status := player
+			g.PlayerStatuses[status.ID] = &status
+		}
+	}
+	for _, player := range players {
+		if player.ID != g.MyID {
+			status := player
+			g.addPlayer(&status)
 		}
-		g.PlayerStatuses[status.ID] = status
-		if status.ID != g.MyID {
-			g.EnemyIDs = append(g.EnemyIDs, status.ID)
+	}
+}
+
+// 自分以外のプレイヤーを味方か敵として追加する
+func (g *Game) addPlayer(status *PlayerStatus) {
+	g.PlayerStatuses[status.ID] = status
+	if g.isAlly(status) {
+		g.AllyIDs = append(g.AllyIDs, status.ID)
+	} else {
+		g.EnemyIDs = append(g.EnemyIDs, status.ID)
+	}
+}
+
+// 同じチームの味方か。個人戦では全員敵
+func (g *Game) isAlly(status *PlayerStatus) bool {
+	me, ok := g.PlayerStatuses[g.MyID]
+	return ok && me.Team != 0 && me.Team == status.Team
+}
+
+func removeID(ids []string, id string) []string {
+	result := []string{}
+	for _, i := range ids {
+		if i != id {
+			result = append(result, i)
 		}
 	}
-	return nil
+	return result
 }
 
 func (g *Game) handleModeChangeAction(action ModeChange) {
+	g.Mutex.Lock()
+	changed := g.changeTarget(action)
+	g.Mutex.Unlock()
+	if changed {
+		g.notifyTarget()
+	}
+}
+
+// 攻撃対象を変え、変わった場合はtrueを返す。g.Mutexをロックして呼ぶ
+func (g *Game) changeTarget(action ModeChange) bool {
+	// 観戦者は攻撃しない
+	if g.Spectator {
+		return false
+	}
+	target, targetMode := g.Target, g.TargetMode
 	switch mode := action.Mode.(type) {
 	case Random:
-		id := g.EnemyIDs[rand.Intn(len(g.EnemyIDs))]
-		g.Target = g.PlayerStatuses[id].ID
+		g.TargetMode = proto.TargetMode_DIRECT
+		g.Target = ""
+		if alive := g.aliveEnemyIDs(); len(alive) > 0 {
+			g.Target = alive[rand.Intn(len(alive))]
+		}
 	case Aim:
-		if mode.Target < len(g.EnemyIDs) {
+		if mode.Target < len(g.EnemyIDs) && !g.PlayerStatuses[g.EnemyIDs[mode.Target]].Eliminated {
+			g.TargetMode = proto.TargetMode_DIRECT
 			g.Target = g.EnemyIDs[mode.Target]
 		}
+	case Auto:
+		g.TargetMode = mode.Target
+		g.Target = ""
+	}
+	return g.Target != target || g.TargetMode != targetMode
+}
+
+// 攻撃対象が変わったことをサーバへ通知する
+func (g *Game) notifyTarget() {
+	g.Mutex.RLock()
+	target, targetMode := g.Target, g.TargetMode
+	g.Mutex.RUnlock()
+	g.handleAimAction(target, targetMode)
+}
+
+// 攻撃可能な敵プレイヤー。g.Mutexをロックして呼ぶ
+func (g *Game) aliveEnemyIDs() []string {
+	alive := []string{}
+	for _, id := range g.EnemyIDs {
+		if !g.PlayerStatuses[id].Eliminated {
+			alive = append(alive, id)
+		}
+	}
+	return alive
+}
+
+// 攻撃目標がいなくなった場合は別の目標をランダムに選ぶ
+func (g *Game) retarget(id string) {
+	g.Mutex.Lock()
+	changed := g.Target == id && g.changeTarget(ModeChange{Mode: Random{}})
+	g.Mutex.Unlock()
+	if changed {
+		g.notifyTarget()
+	}
+}
+
+func (g *Game) handleEliminatedEvent(event EliminatedEvent) {
+	g.Mutex.Lock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		g.Mutex.Unlock()
+		return
+	}
+	status.Eliminated = true
+	g.Mutex.Unlock()
+	if event.ID == g.MyID {
+		g.Logger.PutString(fmt.Sprintln("You are eliminated..."))
+	} else {
+		g.Logger.PutString(fmt.Sprintf("%v is eliminated\n", status.Name))
+	}
+	g.retarget(event.ID)
+}
+
+func (g *Game) handleLeaveEvent(event LeaveEvent) {
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
 	}
+	g.Logger.PutString(fmt.Sprintf("%v left the game\n", status.Name))
+	g.Mutex.Lock()
+	delete(g.PlayerStatuses, event.ID)
+	g.EnemyIDs = removeID(g.EnemyIDs, event.ID)
+	g.AllyIDs = removeID(g.AllyIDs, event.ID)
+	g.Mutex.Unlock()
+	g.retarget(event.ID)
 }
 
 func (g *Game) handleDamageEvent(event DamageEvent) {
-	g.PlayerStatuses[event.ID].Health = event.Damage
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
+	}
+	status.Health = event.Damage
+	status.LastDamage = event.Amount
+	status.LastHeal = 0
+}
+
+func (g *Game) handleHealEvent(event HealEvent) {
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
+	}
+	status.Health = event.Health
+	status.LastHeal = event.Amount
+	status.LastDamage = 0
+	if event.ID == g.MyID {
+		g.Logger.PutString(fmt.Sprintf("Healed %v HP\n", event.Amount))
+	}
+}
+
+func (g *Game) handleShieldEvent(event ShieldEvent) {
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
+	}
+	// シールドが増えたときだけ表示する
+	if event.ID == g.MyID && event.Shield > status.Shield {
+		g.Logger.PutString(fmt.Sprintf("Shield up! %v\n", event.Shield))
+	}
+	status.Shield = event.Shield
+}
+
+func (g *Game) handleComboEvent(event ComboEvent) {
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
+	}
+	// 倍率が上がったときだけ表示する
+	if event.ID == g.MyID && event.Multiplier > status.Multiplier && event.Multiplier > 1 {
+		g.Logger.PutString(fmt.Sprintf("%v combo! damage x%v\n", event.Combo, event.Multiplier))
+	}
+	status.Combo = event.Combo
+	status.Multiplier = event.Multiplier
+}
+
+func (g *Game) handleErrorEvent(event ErrorEvent) {
+	g.Logger.PutString(fmt.Sprintf("Error: %v (%v)\n", event.Message, event.Code))
+}
+
+func (g *Game) handleTargetChangedEvent(event TargetChangedEvent) {
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	status, ok := g.PlayerStatuses[event.ID]
+	if !ok {
+		return
+	}
+	if event.Target == g.MyID && status.Target != g.MyID {
+		g.Logger.PutString(fmt.Sprintf("%v is aiming at you\n", status.Name))
+	}
+	status.Target = event.Target
+}
+
+// 自分を狙っている敵の名前
+func (g *Game) aimingAtMe() []string {
+	names := []string{}
+	for _, id := range g.EnemyIDs {
+		if status := g.PlayerStatuses[id]; status.Target == g.MyID && !status.Eliminated {
+			names = append(names, status.Name)
+		}
+	}
+	return names
+}
+
+func (g *Game) handleClockEvent(event ClockEvent) {
+	if event.SuddenDeath && !g.SuddenDeath {
+		g.Logger.PutString(fmt.Sprintln("Time up! Sudden death"))
+	}
+	if event.SuddenDeath {
+		g.Logger.PutString(fmt.Sprintf("Damage x%v\n", event.Multiplier))
+	}
+	g.Deadline = time.Now().Add(event.Remaining)
+	g.SuddenDeath = event.SuddenDeath
+	g.DamageScale = event.Multiplier
 }
 
 func (g *Game) handleJoinEvent(event JoinEvent) {
@@ -117,28 +485,169 @@ func (g *Game) handleJoinEvent(event JoinEvent) {
 		ID:     event.ID,
 		Name:   event.Name,
 		Health: event.Health,
+		Bot:    event.Bot,
+		Team:   event.Team,
 	}
-	g.EnemyIDs = append(g.EnemyIDs, playerInfo.ID)
-	g.PlayerStatuses[event.ID] = &playerInfo
+	g.Mutex.Lock()
+	g.addPlayer(&playerInfo)
+	g.Mutex.Unlock()
 }
 
 func (g *Game) handleStartEvent(event StartEvent) {
-	g.handleModeChangeAction(ModeChange{Mode: Random{}})
-	limit := 5 * time.Second
-	count := 0
-	output := []string{"4", "3", "2", "1", "start!!"}
-	for begin := time.Now(); time.Since(begin) < limit; {
-		g.Logger.PutString(fmt.Sprintln(output[count]))
-		count += 1
-		time.Sleep(1 * time.Second)
+	// 再戦時は体力が初期値に戻る
+	g.Mutex.Lock()
+	for _, player := range event.Players {
+		if status, ok := g.PlayerStatuses[player.ID]; ok {
+			status.Health = player.Health
+			status.LastDamage = 0
+			status.LastHeal = 0
+			status.Shield = player.Shield
+			status.Combo = 0
+			status.Multiplier = 0
+			status.Target = ""
+			status.Progress = 0
+			status.Eliminated = false
+			status.Word = ""
+			status.Reading = ""
+			status.Effect = ""
+		}
+	}
+	g.Results = nil
+	// サーバが攻撃対象を選ぶ場合はそのまま
+	changed := g.TargetMode == proto.TargetMode_DIRECT && g.changeTarget(ModeChange{Mode: Random{}})
+	g.Mutex.Unlock()
+	if changed {
+		g.notifyTarget()
 	}
+	g.Word = ""
+	g.Reading = ""
+	g.WordDamage = 0
+	g.WordEffect = ""
+	g.Deadline = time.Time{}
+	g.SuddenDeath = false
+	g.DamageScale = 1
+	// 残り秒数はお題の枠に表示する
+	g.StartsAt = time.Now().Add(event.Countdown)
+	g.Logger.PutString(fmt.Sprintf("Starting in %v\n", event.Countdown.Round(time.Second)))
 }
 
 func (g *Game) handleFinishEvent(event FinishEvent) {
-	g.Logger.PutString(fmt.Sprintf("Finish! %v Win!!\n", event.Winner))
+	g.Mutex.Lock()
+	g.Results = event.Results
+	g.Winner = event.Winner
+	g.Mutex.Unlock()
+	g.Deadline = time.Time{}
+	if g.Spectator {
+		g.Logger.PutString(fmt.Sprintf("Finish! %v Win!!\n", event.Winner))
+		return
+	}
+	g.Logger.PutString(fmt.Sprintln("Type !rematch to play again"))
 	g.Logger.PutString(fmt.Sprintln("Press contrl+c to exit"))
 }
 
+func (g *Game) handleRematchVoteEvent(event RematchVoteEvent) {
+	g.Logger.PutString(fmt.Sprintf("Rematch vote %v/%v\n", event.Vote, event.Required))
+}
+
 func (g *Game) handleQuestionEvent(event QuestionEvent) {
+	// 観戦時は各プレイヤーのお題として表示する
+	if event.ID != "" {
+		g.Mutex.Lock()
+		if status, ok := g.PlayerStatuses[event.ID]; ok {
+			status.Word = event.Text
+			status.Reading = event.Reading
+			status.Effect = event.Effect
+		}
+		g.Mutex.Unlock()
+		return
+	}
+	// カウントダウン後の最初のお題
+	if !g.StartsAt.IsZero() {
+		g.StartsAt = time.Time{}
+		g.Logger.PutString(fmt.Sprintln("start!!"))
+	}
 	g.Word = event.Text
+	g.Reading = event.Reading
+	g.WordDamage = event.Damage
+	g.WordEffect = event.Effect
+}
+
+func (g *Game) handleAttackResultEvent(event AttackResultEvent) {
+	if event.Hit {
+		return
+	}
+
+	switch {
+	case event.Penalty == "" && event.Lockout > 0:
+		g.Logger.PutString(fmt.Sprintf("Locked! wait %.1fs\n", event.Lockout.Seconds()))
+	case event.Penalty == "self":
+		g.Logger.PutString(fmt.Sprintln("Miss! 1 damage to yourself"))
+	case event.Penalty == "lockout":
+		g.Logger.PutString(fmt.Sprintf("Miss! locked for %.1fs\n", event.Lockout.Seconds()))
+	case event.Penalty == "skip":
+		g.Logger.PutString(fmt.Sprintln("Miss! the word is changed"))
+	default:
+		g.Logger.PutString(fmt.Sprintln("Miss!"))
+	}
+}
+
+func (g *Game) handleProgressEvent(event ProgressEvent) {
+	g.Mutex.Lock()
+	defer g.Mutex.Unlock()
+	if status, ok := g.PlayerStatuses[event.ID]; ok {
+		status.Progress = event.Percent
+	}
+}
+
+// 再接続または観戦を始めたときは受け取った状態で表示を作り直す
+func (g *Game) handleSnapshotEvent(event SnapshotEvent) {
+	players := []PlayerStatus{}
+	for _, player := range event.Players {
+		player.Eliminated = player.Health <= 0
+		players = append(players, player)
+	}
+	g.Mutex.Lock()
+	g.resetPlayers(players)
+	status, ok := g.PlayerStatuses[g.Target]
+	changed := g.TargetMode == proto.TargetMode_DIRECT && (!ok || status.Eliminated) && g.changeTarget(ModeChange{Mode: Random{}})
+	g.Mutex.Unlock()
+	if changed {
+		g.notifyTarget()
+	}
+
+	g.Phase = event.Phase
+	g.Word = ""
+	g.Reading = ""
+	g.WordDamage = 0
+	g.WordEffect = ""
+	g.Deadline = time.Time{}
+	g.StartsAt = time.Time{}
+	g.SuddenDeath = false
+	g.DamageScale = 1
+	if event.Clock != nil {
+		g.handleClockEvent(*event.Clock)
+	}
+	if event.Question != nil {
+		g.handleQuestionEvent(*event.Question)
+	}
+	for _, word := range event.Words {
+		g.handleQuestionEvent(word)
+	}
+}
+
+func (g *Game) handleReconnectEvent(event ReconnectEvent) {
+	g.Logger.PutString(fmt.Sprintln("Reconnected"))
+}
+
+func (g *Game) handleDisconnectEvent(event DisconnectEvent) {
+	if event.Reconnecting {
+		g.Logger.PutString(fmt.Sprintln("Connection lost. Reconnecting..."))
+		return
+	}
+	g.Logger.PutString(fmt.Sprintln("Disconnected from the server"))
+	g.Logger.PutString(fmt.Sprintln("Press contrl+c to exit"))
+}
+
+func (g *Game) handleStateEvent(event StateEvent) {
+	g.Phase = event.Phase
 }
@@ -2,21 +2,29 @@ package client
 
 import (
 	"fmt"
+	"math"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/mattn/go-runewidth"
 	"github.com/rivo/tview"
+	"github.com/yoRyuuuuu/typex/proto"
 )
 
 const refreshInterval = 16 * time.Millisecond
 
+// 進捗バーの幅
+const progressBarWidth = 20
+
 type View struct {
 	app           *tview.Application
 	logger        *tview.TextView
 	problemView   *tview.TextView
 	playerView    *tview.Flex
+	resultTable   *tview.Table
+	mainView      *tview.Flex
 	inputField    *tview.InputField
 	drawCallbacks []func()
 	*Game
@@ -35,19 +43,65 @@ func (v *View) refresh() {
 
 func (v *View) setupProblemView() {
 	v.problemView.SetTitle("Problem").
-		SetBorder(true).
-		SetTitle(v.Word)
+		SetBorder(true)
+	v.problemView.SetDynamicColors(true)
 	callback := func() {
-		v.problemView.SetText(v.Word)
+		// 観戦時はお題を各プレイヤーのパネルに表示する
+		if v.Spectator {
+			v.problemView.SetTitle(fmt.Sprintf("Spectate (%v)%v", v.Phase, v.clockText()))
+			v.problemView.SetText(fmt.Sprintf("Watching %v players", len(v.EnemyIDs)))
+			return
+		}
+		v.problemView.SetTitle(fmt.Sprintf("Problem (%v)%v", v.Phase, v.clockText()))
+		if remaining := time.Until(v.StartsAt); v.Word == "" && remaining > 0 {
+			v.problemView.SetText(fmt.Sprintf("Starting in %v...", int(math.Ceil(remaining.Seconds()))))
+			return
+		}
+		text := v.Word
+		if v.Reading != "" {
+			text = fmt.Sprintf("%v (%v)", v.Word, v.Reading)
+		}
+		// 与えるダメージが1より大きい場合は表示する
+		if v.WordDamage > 1 {
+			text = fmt.Sprintf("%v [x%v]", text, v.WordDamage)
+		}
+		// 特殊なお題は効果を色付きで先頭に表示する
+		v.problemView.SetText(effectLabel(v.WordEffect) + tview.Escape(text))
 	}
 	v.drawCallbacks = append(v.drawCallbacks, callback)
 }
 
+// 制限時間の残り、またはサドンデスの倍率と次に倍率が上がるまでの時間
+func (v *View) clockText() string {
+	if v.Deadline.IsZero() {
+		return ""
+	}
+	remaining := time.Until(v.Deadline).Round(time.Second)
+	if remaining < 0 {
+		remaining = 0
+	}
+	clock := fmt.Sprintf("%d:%02d", int(remaining.Minutes()), int(remaining.Seconds())%60)
+	if v.SuddenDeath {
+		return fmt.Sprintf(" SUDDEN DEATH x%v %v", v.DamageScale, clock)
+	}
+	return " " + clock
+}
+
 func (v *View) setupInputField() {
 	v.inputField.SetLabel("Input: ").
 		SetTitle("Terminal").
 		SetBorder(true)
 
+	// 入力のたびに進捗を送信する
+	v.inputField.SetChangedFunc(func(text string) {
+		if strings.HasPrefix(text, "!") {
+			return
+		}
+		v.ActionReceiver <- Progress{
+			Text: text,
+		}
+	})
+
 	v.inputField.SetInputCapture(func(event *tcell.EventKey) *tcell.EventKey {
 		switch event.Key() {
 		case tcell.KeyEnter:
@@ -62,6 +116,14 @@ func (v *View) setupInputField() {
 					v.ActionReceiver <- ModeChange{
 						Mode: Random{},
 					}
+				case "rematch":
+					v.ActionReceiver <- Rematch{}
+				case "lowest", "highest", "revenge", "attacker":
+					v.ActionReceiver <- ModeChange{
+						Mode: Auto{
+							Target: targetCommands[input[1:]],
+						},
+					}
 				default:
 					target, _ := strconv.Atoi(input[1:])
 					v.ActionReceiver <- ModeChange{
@@ -95,35 +157,209 @@ func (v *View) drawLogger() {
 	v.logger.SetText(v.Logger.String())
 }
 
+func (v *View) setupResultTable() {
+	v.resultTable.SetTitle("Result").
+		SetBorder(true)
+	v.drawCallbacks = append(v.drawCallbacks, v.drawResultTable)
+}
+
+// ゲーム終了後は成績表を表示する
+func (v *View) drawResultTable() {
+	v.Mutex.RLock()
+	defer v.Mutex.RUnlock()
+	v.resultTable.Clear()
+	v.resultTable.SetTitle(fmt.Sprintf("Result - %v Win!!", tview.Escape(v.Winner)))
+	if len(v.Results) == 0 {
+		v.mainView.ResizeItem(v.resultTable, 0, 0)
+		return
+	}
+
+	header := []string{"#", "Name", "Dealt", "Taken", "Words", "Acc", "WPM", "Time"}
+	for column, text := range header {
+		v.resultTable.SetCell(0, column, tview.NewTableCell(text).
+			SetTextColor(tcell.ColorYellow).
+			SetSelectable(false))
+	}
+	for i, result := range v.Results {
+		row := []string{
+			strconv.Itoa(result.Rank),
+			result.Name,
+			strconv.Itoa(result.DamageDealt),
+			strconv.Itoa(result.DamageTaken),
+			strconv.Itoa(result.Hit),
+			fmt.Sprintf("%v%%", result.Accuracy()),
+			fmt.Sprintf("%.1f", result.WPM),
+			fmt.Sprintf("%.0fs", result.Survived.Seconds()),
+		}
+		for column, text := range row {
+			cell := tview.NewTableCell(text)
+			if result.ID == v.MyID {
+				cell.SetTextColor(tcell.ColorRed)
+			}
+			v.resultTable.SetCell(i+1, column, cell)
+		}
+	}
+	// ヘッダと枠線の分を加える
+	v.mainView.ResizeItem(v.resultTable, len(v.Results)+3, 0)
+}
+
 func (v *View) setupPlayerView() {
 	v.drawCallbacks = append(v.drawCallbacks, v.drawPlayerView)
 }
 
 func (v *View) drawPlayerView() {
+	v.Mutex.RLock()
+	defer v.Mutex.RUnlock()
 	// 描画をリセット
 	v.playerView.Clear()
+	// 観戦時は全員のパネルを描画する
+	if v.Spectator {
+		for _, id := range v.EnemyIDs {
+			v.playerView.AddItem(spectatorPanel(v.PlayerStatuses[id], v.Settings.InitialHealth), 5, 0, false)
+		}
+		return
+	}
 	// 自分のスコアを描画
+	me := v.PlayerStatuses[v.MyID]
 	mine := tview.NewTextView()
-	mine.SetTitle("YOU").
-		SetBorder(true)
-	mine.SetText(fmt.Sprintf("HP: %v", v.PlayerStatuses[v.MyID].Health))
-	v.playerView.AddItem(mine, 3, 0, false)
+	title := "YOU"
+	// サーバが攻撃対象を選ぶ場合は選び方を表示する
+	if v.TargetMode != proto.TargetMode_DIRECT {
+		title = fmt.Sprintf("YOU (target: %v)", targetCommand(v.TargetMode))
+	}
+	mine.SetTitle(title).
+		SetBorder(true).
+		SetBorderColor(teamColor(me.Team))
+	mine.SetText(fmt.Sprintf("%v\n%v", healthText(me, v.Settings.InitialHealth), aimedText(v.aimingAtMe())))
+	v.playerView.AddItem(mine, 4, 0, false)
+	// 味方のスコアを描画
+	for _, id := range v.AllyIDs {
+		v.playerView.AddItem(playerPanel(v.PlayerStatuses[id], displayName(v.PlayerStatuses[id]), v.Settings.InitialHealth), 4, 0, false)
+	}
 	for _, id := range v.EnemyIDs {
 		// 他プレイヤーのスコアを描画
 		player := v.PlayerStatuses[id]
-		text := tview.NewTextView()
-
-		name := player.Name
+		name := displayName(player)
 		// 攻撃目標なら赤色
 		if id == v.Target {
 			name = fmt.Sprintf("[red]%v", name)
 		}
-		text.SetTitle(name).
-			SetBorder(true)
+		v.playerView.AddItem(playerPanel(player, name, v.Settings.InitialHealth), 4, 0, false)
+	}
+}
+
+// 体力と進捗を表示するパネル。枠の色はチームの色
+func playerPanel(player *PlayerStatus, title string, maxHealth int) *tview.TextView {
+	text := tview.NewTextView()
+	text.SetTitle(title).
+		SetBorder(true).
+		SetBorderColor(teamColor(player.Team))
+
+	if player.Eliminated {
+		text.SetText("ELIMINATED")
+	} else {
+		text.SetText(fmt.Sprintf("%v\n%v", healthText(player, maxHealth), progressBar(player.Progress)))
+	}
+	return text
+}
+
+// 観戦時のパネル。体力、お題、進捗を表示する
+func spectatorPanel(player *PlayerStatus, maxHealth int) *tview.TextView {
+	text := tview.NewTextView()
+	text.SetTitle(displayName(player)).
+		SetBorder(true).
+		SetBorderColor(teamColor(player.Team))
+	text.SetDynamicColors(true)
+	if player.Eliminated {
+		text.SetText("ELIMINATED")
+		return text
+	}
+
+	word := player.Word
+	if player.Reading != "" {
+		word = fmt.Sprintf("%v (%v)", player.Word, player.Reading)
+	}
+	word = effectLabel(player.Effect) + tview.Escape(word)
+	text.SetText(fmt.Sprintf("%v\n%v\n%v", healthText(player, maxHealth), word, progressBar(player.Progress)))
+	return text
+}
+
+// チームごとの枠の色
+var teamColors = []tcell.Color{
+	tcell.ColorBlue,
+	tcell.ColorGreen,
+	tcell.ColorYellow,
+	tcell.ColorFuchsia,
+	tcell.ColorAqua,
+	tcell.ColorOrange,
+}
+
+// 個人戦では通常の枠の色
+func teamColor(team int) tcell.Color {
+	if team == 0 {
+		return tview.Styles.BorderColor
+	}
+	return teamColors[(team-1)%len(teamColors)]
+}
+
+// ボットは名前に印を付ける
+func displayName(player *PlayerStatus) string {
+	if player.Bot {
+		return fmt.Sprintf("%v (BOT)", player.Name)
+	}
+	return player.Name
+}
+
+// 体力と直前に受けたダメージまたは回復量、残っているシールド、コンボ
+// maxHealthが0の場合は初期体力を表示しない
+func healthText(player *PlayerStatus, maxHealth int) string {
+	text := fmt.Sprintf("HP: %v", player.Health)
+	if maxHealth > 0 {
+		text = fmt.Sprintf("HP: %v/%v", player.Health, maxHealth)
+	}
+	if player.LastDamage > 0 {
+		text = fmt.Sprintf("%v (-%v)", text, player.LastDamage)
+	} else if player.LastHeal > 0 {
+		text = fmt.Sprintf("%v (+%v)", text, player.LastHeal)
+	}
+	if player.Shield > 0 {
+		text = fmt.Sprintf("%v Shield: %v", text, player.Shield)
+	}
+	// 2回以上連続で正解している場合はコンボを表示する
+	if player.Combo > 1 {
+		text = fmt.Sprintf("%v Combo: %v", text, player.Combo)
+		if player.Multiplier > 1 {
+			text = fmt.Sprintf("%v (x%v)", text, player.Multiplier)
+		}
+	}
+	return text
+}
 
-		text.SetText(fmt.Sprintf("HP: %v", player.Health))
-		v.playerView.AddItem(text, 3, 0, false)
+// 特殊なお題の効果の色付きの表示
+func effectLabel(effect string) string {
+	switch effect {
+	case "HEAL":
+		return "[green]HEAL[-] "
+	case "SHIELD":
+		return "[aqua]SHIELD[-] "
+	case "DOUBLE_DAMAGE":
+		return "[yellow]DOUBLE[-] "
 	}
+	return ""
+}
+
+// 自分を狙っている敵の数と名前
+func aimedText(names []string) string {
+	if len(names) == 0 {
+		return "Aimed by nobody"
+	}
+	return fmt.Sprintf("Aimed by %v: %v", len(names), strings.Join(names, ", "))
+}
+
+// お題の入力の進捗バー
+func progressBar(percent int) string {
+	filled := progressBarWidth * percent / 100
+	return strings.Repeat("█", filled) + strings.Repeat("░", progressBarWidth-filled)
 }
 
 func NewView(game *Game) *View {
@@ -136,9 +372,14 @@ func NewView(game *Game) *View {
 	// 単語のViewを配置
 	problemView := tview.NewTextView()
 	flex.AddItem(problemView, 3, 0, false)
-	// inpuFieldを配置
+	// inpuFieldを配置。観戦時は入力しない
 	inputField := tview.NewInputField()
-	flex.AddItem(inputField, 3, 0, true)
+	if !game.Spectator {
+		flex.AddItem(inputField, 3, 0, true)
+	}
+	// 成績表を配置。ゲーム終了まで高さは0
+	resultTable := tview.NewTable()
+	flex.AddItem(resultTable, 0, 0, false)
 	// loggerを配置
 	logger := tview.NewTextView()
 	flex.AddItem(logger, 0, 1, false)
@@ -153,6 +394,8 @@ func NewView(game *Game) *View {
 		logger:        logger,
 		inputField:    inputField,
 		playerView:    playerView,
+		resultTable:   resultTable,
+		mainView:      flex,
 		drawCallbacks: []func(){},
 		Game:          game,
 	}
@@ -162,6 +405,7 @@ func NewView(game *Game) *View {
 	view.setupInputField()
 	view.setupLogger()
 	view.setupPlayerView()
+	view.setupResultTable()
 
 	view.app.SetRoot(root, true)
 	return view
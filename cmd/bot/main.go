@@ -0,0 +1,67 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/yoRyuuuuu/typex/client"
+	"github.com/yoRyuuuuu/typex/proto"
+	"google.golang.org/grpc"
+)
+
+func main() {
+	address := flag.String("addr", "localhost", "The address to listen on.")
+	port := flag.Int("port", 8743, "The port to listen on.")
+	name := flag.String("name", "Bot", "Bot name. A number is appended when -count is more than 1.")
+	room := flag.String("room", "", "ID of the room to join. Joins the default room if empty.")
+	team := flag.Int("team", 0, "Team to join in a team battle. Assigned automatically if 0.")
+	count := flag.Int("count", 1, "Number of bots to connect.")
+	wpm := flag.Float64("wpm", 40, "Typing speed in words per minute (5 keystrokes per word).")
+	errorRate := flag.Float64("error", 0.05, "Probability of submitting a mistyped word (0 to 1).")
+	target := flag.String("target", "random", "Targeting strategy (random, weakest, strongest, revenge, attacker)")
+	flag.Parse()
+
+	strategy, err := client.ParseStrategy(*target)
+	if err != nil {
+		log.Fatalf("invalid target: %v", err)
+	}
+	if *wpm <= 0 {
+		log.Fatalf("invalid wpm: %v", *wpm)
+	}
+	if *errorRate < 0 || *errorRate > 1 {
+		log.Fatalf("invalid error rate: %v", *errorRate)
+	}
+
+	conn, err := grpc.Dial(fmt.Sprintf("%v:%v", *address, *port), grpc.WithInsecure())
+	if err != nil {
+		log.Fatalf("can Not connect with server %v", err)
+	}
+	grpcClient := proto.NewGameClient(conn)
+
+	bots := []*client.Bot{}
+	for i := 1; i <= *count; i++ {
+		botName := *name
+		if *count > 1 {
+			botName = fmt.Sprintf("%v%v", *name, i)
+		}
+
+		clt := client.NewGameClient()
+		bot := client.NewBot(clt, *wpm, *errorRate, strategy)
+		if err := bot.Connect(grpcClient, botName, *room, *team); err != nil {
+			log.Fatalf("connect request failed: %v", client.DescribeError(err))
+		}
+		bot.Start()
+		clt.Start()
+		bots = append(bots, bot)
+		log.Printf("%v joined [WPM: %v, Error: %v, Target: %v]", botName, *wpm, *errorRate, strategy)
+
+		// サーバがストリームを受け付けてから次のボットを参加させる
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	for _, bot := range bots {
+		<-bot.Done
+	}
+}
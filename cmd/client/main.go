@@ -14,6 +14,14 @@ func main() {
 	address := flag.String("addr", "localhost", "The address to listen on.")
 	port := flag.Int("port", 8743, "The port to listen on.")
 	name := flag.String("name", "Hoge", "Player name")
+	room := flag.String("room", "", "ID of the room to join. Joins the default room if empty.")
+	create := flag.String("create", "", "Create a room with this name and join it.")
+	capacity := flag.Int("capacity", 0, "Number of players in the created room. Uses the server default if 0.")
+	dataset := flag.String("dataset", "", "Word dataset of the created room (en, ja). Uses the server default if empty.")
+	teams := flag.Int("teams", 0, "Number of teams in the created room. Free-for-all if 0.")
+	team := flag.Int("team", 0, "Team to join in a team battle. Assigned automatically if 0.")
+	list := flag.Bool("list", false, "List the rooms on the server and exit.")
+	spectate := flag.Bool("spectate", false, "Watch the room without playing.")
 	flag.Parse()
 
 	conn, err := grpc.Dial(fmt.Sprintf("%v:%v", *address, *port), grpc.WithInsecure())
@@ -21,13 +29,37 @@ func main() {
 		log.Fatalf("can Not connect with server %v", err)
 	}
 	grpcClient := proto.NewGameClient(conn)
+
+	if *list {
+		rooms, err := client.ListRooms(grpcClient)
+		if err != nil {
+			log.Fatalf("list rooms request failed: %v", client.DescribeError(err))
+		}
+		for _, r := range rooms {
+			fmt.Printf("%v\t%v\t%v/%v\t%v\tteams=%v\tstarted=%v\tspectators=%v\n", r.Id, r.Name, r.Player, r.Capacity, r.Dataset, r.Teams, r.Started, r.Spectator)
+		}
+		return
+	}
+
+	if *create != "" {
+		r, err := client.CreateRoom(grpcClient, *create, *capacity, *dataset, *teams)
+		if err != nil {
+			log.Fatalf("create room request failed: %v", client.DescribeError(err))
+		}
+		*room = r.Id
+	}
+
 	clt := client.NewGameClient()
 	game := client.NewGame(clt)
-	err = game.Connect(grpcClient, *name)
+	if *spectate {
+		err = game.Spectate(grpcClient, *name, *room)
+	} else {
+		err = game.Connect(grpcClient, *name, *room, *team)
+	}
 	view := client.NewView(game)
 
 	if err != nil {
-		log.Fatalf("connect request failed %v", err)
+		log.Fatalf("connect request failed: %v", client.DescribeError(err))
 	}
 	game.Start()
 	clt.Start()
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"time"
 
 	"github.com/yoRyuuuuu/typex/proto"
 	"github.com/yoRyuuuuu/typex/server"
@@ -12,28 +13,169 @@ import (
 )
 
 func main() {
+	// フラグを指定しなかった値はデフォルトのルールを使う
+	rules := server.CurrentRules()
+
 	// ポート番号
 	port := flag.String("port", "8743", "The port to listen")
+	// ルールのプリセットを書いた設定ファイル
+	config := flag.String("config", "", "JSON file of rule presets")
+	// 使うルールのプリセット
+	preset := flag.String("preset", "", "Rule preset (quick, marathon, hardcore or a preset in -config). Flags on the command line take precedence")
 	// ゲームのプレイヤー数
-	player := flag.Int("player", 5, "Number of players in the game")
+	flag.IntVar(&rules.Player, "player", rules.Player, "Number of players in the game")
+	// デフォルトの部屋のチーム数
+	flag.IntVar(&rules.Teams, "teams", rules.Teams, "Number of teams in the default room (0 for free-for-all)")
+	// デフォルトの部屋で使うデータセット
+	flag.StringVar(&rules.Dataset, "dataset", rules.Dataset, "Word dataset of the default room (en, ja or a loaded word list)")
+	// 単語リストのファイルまたはディレクトリ
+	words := flag.String("words", "", "Word list file or directory of word lists (.txt, .json, .csv)")
+	// プレイヤーの初期体力
+	flag.IntVar(&rules.Health, "health", rules.Health, "Initial health of a player")
+	// ダメージの計算方法
+	flag.StringVar(&rules.Damage, "damage", rules.Damage, "Damage model (flat, scaled)")
+	// 入力ミスのペナルティ
+	flag.StringVar(&rules.Penalty, "penalty", rules.Penalty, "Penalty for a wrong answer (none, self, lockout, skip)")
+	// 特殊なお題が出る確率
+	flag.Float64Var(&rules.PowerUp, "powerup", rules.PowerUp, "Probability of a heal, shield or double-damage word (0 to 1)")
+	// コンボが途切れるまでの時間
+	flag.DurationVar((*time.Duration)(&rules.ComboTimeout), "combo-timeout", time.Duration(rules.ComboTimeout), "Combo breaks after this pause between correct words (0 to disable combos)")
+	// 対戦の制限時間
+	flag.DurationVar((*time.Duration)(&rules.TimeLimit), "time-limit", time.Duration(rules.TimeLimit), "Time limit of a match (0 for no limit)")
+	// サドンデスでダメージが2倍になる間隔
+	flag.DurationVar((*time.Duration)(&rules.SuddenDeath), "sudden-death", time.Duration(rules.SuddenDeath), "Interval at which damage doubles in sudden death")
+	// 全員揃ってからカウントダウンを始めるまでの時間
+	flag.DurationVar((*time.Duration)(&rules.StartDelay), "start-delay", time.Duration(rules.StartDelay), "Delay before the countdown after all players joined")
+	// カウントダウンの長さ
+	flag.DurationVar((*time.Duration)(&rules.Countdown), "countdown", time.Duration(rules.Countdown), "Countdown before the first word")
+	// メッセージを送らないプレイヤーを切断するまでの時間
+	flag.DurationVar((*time.Duration)(&rules.ClientTimeout), "client-timeout", time.Duration(rules.ClientTimeout), "Disconnect a player who sends nothing for this long")
+	// 切断したプレイヤーの再接続を待つ時間
+	flag.DurationVar((*time.Duration)(&rules.Reconnect), "reconnect", time.Duration(rules.Reconnect), "Grace period for a disconnected player to reconnect (0 to disable)")
+	// クライアントごとの送信キューの長さ
+	queueSize := flag.Int("queue-size", 256, "Outbound event queue size per client")
+	// 送信キューが一杯になったときの対応
+	slowConsumer := flag.String("slow-consumer", "disconnect", "Policy for a client whose queue is full (drop, coalesce, disconnect)")
+	// 送信キューの状態をログに出す間隔
+	metrics := flag.Duration("metrics", 0, "Interval to log queue depth of each client (0 to disable)")
+	// 1クライアントが1秒間に送れるリクエスト数
+	rateLimit := flag.Int("rate-limit", 50, "Maximum requests per second from a client (0 to disable)")
+	// ゲーム開始後に参加しようとしたプレイヤーへの対応
+	lateJoin := flag.String("late-join", "reject", "Policy for a player joining after the game started (reject, spectate, play)")
+	// 誰もいない開始前の部屋を片付けるまでの時間
+	roomIdle := flag.Duration("room-idle", 5*time.Minute, "Close an empty room that has not started after this time (0 to keep)")
+	// 空き枠をボットで埋めるまでの待ち時間
+	botWait := flag.Duration("bot-wait", 0, "Fill empty slots with bots after this wait (0 to disable)")
+	// ボットの強さ
+	botLevel := flag.String("bot-level", "normal", "Bot difficulty (easy, normal, hard)")
 	flag.Parse()
 
+	policy, err := server.ParseSlowConsumerPolicy(*slowConsumer)
+	if err != nil {
+		log.Fatalf("invalid slow consumer policy: %v", err)
+	}
+	if *queueSize <= 0 {
+		log.Fatalf("invalid queue size: %v", *queueSize)
+	}
+	server.SlowConsumer = policy
+	server.QueueSize = *queueSize
+	server.MetricsInterval = *metrics
+
+	if *rateLimit < 0 {
+		log.Fatalf("invalid rate limit: %v", *rateLimit)
+	}
+	server.RateLimit = *rateLimit
+
+	lateJoinPolicy, err := server.ParseLateJoinPolicy(*lateJoin)
+	if err != nil {
+		log.Fatalf("invalid late join policy: %v", err)
+	}
+	server.LateJoin = lateJoinPolicy
+
+	if *roomIdle < 0 {
+		log.Fatalf("invalid room idle timeout: %v", *roomIdle)
+	}
+	server.RoomIdleTimeout = *roomIdle
+
+	level, err := server.ParseBotLevel(*botLevel)
+	if err != nil {
+		log.Fatalf("invalid bot level: %v", err)
+	}
+	server.BotDifficulty = level
+	server.BotWait = *botWait
+
+	// プリセットは単語リストのデータセットを使えるように単語リストの後で読み込む
+	var names []string
+	if *words != "" {
+		names, err = server.LoadDatasets(*words)
+		if err != nil {
+			log.Fatalf("failed to load word lists: %v", err)
+		}
+		log.Printf("loaded word lists %v", names)
+	}
+
+	if *config != "" || *preset != "" {
+		presets, err := server.LoadPresets(*config)
+		if err != nil {
+			log.Fatalf("failed to load presets: %v", err)
+		}
+		if *preset != "" {
+			selected, ok := presets[*preset]
+			if !ok {
+				log.Fatalf("unknown preset %v (available: %v)", *preset, server.PresetNames(presets))
+			}
+			applyPreset(&rules, selected)
+			log.Printf("use preset %v", *preset)
+		}
+	}
+
+	// ファイルを1つだけ指定した場合は、データセットを指定していなければそれをデフォルトにする
+	if len(names) == 1 && !isFlagSet("dataset") && rules.Dataset == server.DefaultDataset {
+		rules.Dataset = names[0]
+	}
+
+	if err := rules.Apply(); err != nil {
+		log.Fatalf("invalid rules: %v", err)
+	}
+
 	log.Printf("listening on port %s", *port)
 	lis, err := net.Listen("tcp", fmt.Sprintf(":%v", *port))
 	if err != nil {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
-	server.PlayerCount = *player
-
-	game := server.NewGame()
-	game.Start()
+	lobby, err := server.NewLobby()
+	if err != nil {
+		log.Fatalf("failed to create lobby: %v", err)
+	}
 
 	s := grpc.NewServer()
-	server := server.NewGameServer(game)
+	server := server.NewGameServer(lobby)
 	proto.RegisterGameServer(s, server)
 
 	if err := s.Serve(lis); err != nil {
 		log.Fatalf("failed to serve: %v", err)
 	}
 }
+
+// プリセットのルールを使う。コマンドラインで指定したフラグはプリセットより優先する
+func applyPreset(rules *server.Rules, preset server.Rules) {
+	explicit := make(map[string]string)
+	flag.Visit(func(f *flag.Flag) {
+		explicit[f.Name] = f.Value.String()
+	})
+	*rules = preset
+	for name, value := range explicit {
+		flag.Set(name, value)
+	}
+}
+
+func isFlagSet(name string) bool {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == name {
+			set = true
+		}
+	})
+	return set
+}
@@ -20,6 +20,244 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type ErrorCode int32
+
+const (
+	ErrorCode_UNKNOWN_ERROR        ErrorCode = 0
+	ErrorCode_SERVER_FULL          ErrorCode = 1
+	ErrorCode_GAME_STARTED         ErrorCode = 2
+	ErrorCode_INVALID_TARGET       ErrorCode = 3
+	ErrorCode_NOT_YOUR_TURN        ErrorCode = 4
+	ErrorCode_RATE_LIMITED         ErrorCode = 5
+	ErrorCode_INVALID_ARGUMENT     ErrorCode = 6
+	ErrorCode_NOT_FOUND            ErrorCode = 7
+	ErrorCode_INCOMPATIBLE_VERSION ErrorCode = 8
+	ErrorCode_TIMED_OUT            ErrorCode = 9
+	ErrorCode_QUEUE_FULL           ErrorCode = 10
+	ErrorCode_CONNECTION_LOST      ErrorCode = 11
+)
+
+// Enum value maps for ErrorCode.
+var (
+	ErrorCode_name = map[int32]string{
+		0:  "UNKNOWN_ERROR",
+		1:  "SERVER_FULL",
+		2:  "GAME_STARTED",
+		3:  "INVALID_TARGET",
+		4:  "NOT_YOUR_TURN",
+		5:  "RATE_LIMITED",
+		6:  "INVALID_ARGUMENT",
+		7:  "NOT_FOUND",
+		8:  "INCOMPATIBLE_VERSION",
+		9:  "TIMED_OUT",
+		10: "QUEUE_FULL",
+		11: "CONNECTION_LOST",
+	}
+	ErrorCode_value = map[string]int32{
+		"UNKNOWN_ERROR":        0,
+		"SERVER_FULL":          1,
+		"GAME_STARTED":         2,
+		"INVALID_TARGET":       3,
+		"NOT_YOUR_TURN":        4,
+		"RATE_LIMITED":         5,
+		"INVALID_ARGUMENT":     6,
+		"NOT_FOUND":            7,
+		"INCOMPATIBLE_VERSION": 8,
+		"TIMED_OUT":            9,
+		"QUEUE_FULL":           10,
+		"CONNECTION_LOST":      11,
+	}
+)
+
+func (x ErrorCode) Enum() *ErrorCode {
+	p := new(ErrorCode)
+	*p = x
+	return p
+}
+
+func (x ErrorCode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorCode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_main_proto_enumTypes[0].Descriptor()
+}
+
+func (ErrorCode) Type() protoreflect.EnumType {
+	return &file_proto_main_proto_enumTypes[0]
+}
+
+func (x ErrorCode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorCode.Descriptor instead.
+func (ErrorCode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{0}
+}
+
+type TargetMode int32
+
+const (
+	TargetMode_DIRECT         TargetMode = 0
+	TargetMode_RANDOM         TargetMode = 1
+	TargetMode_LOWEST_HEALTH  TargetMode = 2
+	TargetMode_HIGHEST_HEALTH TargetMode = 3
+	TargetMode_REVENGE        TargetMode = 4
+	TargetMode_ATTACKER       TargetMode = 5
+)
+
+// Enum value maps for TargetMode.
+var (
+	TargetMode_name = map[int32]string{
+		0: "DIRECT",
+		1: "RANDOM",
+		2: "LOWEST_HEALTH",
+		3: "HIGHEST_HEALTH",
+		4: "REVENGE",
+		5: "ATTACKER",
+	}
+	TargetMode_value = map[string]int32{
+		"DIRECT":         0,
+		"RANDOM":         1,
+		"LOWEST_HEALTH":  2,
+		"HIGHEST_HEALTH": 3,
+		"REVENGE":        4,
+		"ATTACKER":       5,
+	}
+)
+
+func (x TargetMode) Enum() *TargetMode {
+	p := new(TargetMode)
+	*p = x
+	return p
+}
+
+func (x TargetMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TargetMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_main_proto_enumTypes[1].Descriptor()
+}
+
+func (TargetMode) Type() protoreflect.EnumType {
+	return &file_proto_main_proto_enumTypes[1]
+}
+
+func (x TargetMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TargetMode.Descriptor instead.
+func (TargetMode) EnumDescriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{1}
+}
+
+type Effect int32
+
+const (
+	Effect_NORMAL        Effect = 0
+	Effect_HEAL          Effect = 1
+	Effect_SHIELD        Effect = 2
+	Effect_DOUBLE_DAMAGE Effect = 3
+)
+
+// Enum value maps for Effect.
+var (
+	Effect_name = map[int32]string{
+		0: "NORMAL",
+		1: "HEAL",
+		2: "SHIELD",
+		3: "DOUBLE_DAMAGE",
+	}
+	Effect_value = map[string]int32{
+		"NORMAL":        0,
+		"HEAL":          1,
+		"SHIELD":        2,
+		"DOUBLE_DAMAGE": 3,
+	}
+)
+
+func (x Effect) Enum() *Effect {
+	p := new(Effect)
+	*p = x
+	return p
+}
+
+func (x Effect) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Effect) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_main_proto_enumTypes[2].Descriptor()
+}
+
+func (Effect) Type() protoreflect.EnumType {
+	return &file_proto_main_proto_enumTypes[2]
+}
+
+func (x Effect) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Effect.Descriptor instead.
+func (Effect) EnumDescriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{2}
+}
+
+type Phase int32
+
+const (
+	Phase_WAITING   Phase = 0
+	Phase_COUNTDOWN Phase = 1
+	Phase_PLAYING   Phase = 2
+	Phase_FINISHED  Phase = 3
+)
+
+// Enum value maps for Phase.
+var (
+	Phase_name = map[int32]string{
+		0: "WAITING",
+		1: "COUNTDOWN",
+		2: "PLAYING",
+		3: "FINISHED",
+	}
+	Phase_value = map[string]int32{
+		"WAITING":   0,
+		"COUNTDOWN": 1,
+		"PLAYING":   2,
+		"FINISHED":  3,
+	}
+)
+
+func (x Phase) Enum() *Phase {
+	p := new(Phase)
+	*p = x
+	return p
+}
+
+func (x Phase) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Phase) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_main_proto_enumTypes[3].Descriptor()
+}
+
+func (Phase) Type() protoreflect.EnumType {
+	return &file_proto_main_proto_enumTypes[3]
+}
+
+func (x Phase) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Phase.Descriptor instead.
+func (Phase) EnumDescriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{3}
+}
+
 type Player struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -28,6 +266,11 @@ type Player struct {
 	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name   string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Health int64  `protobuf:"varint,3,opt,name=health,proto3" json:"health,omitempty"`
+	Bot    bool   `protobuf:"varint,4,opt,name=bot,proto3" json:"bot,omitempty"`
+	Team   int64  `protobuf:"varint,5,opt,name=team,proto3" json:"team,omitempty"`
+	Shield int64  `protobuf:"varint,6,opt,name=shield,proto3" json:"shield,omitempty"`
+	Combo  int64  `protobuf:"varint,7,opt,name=combo,proto3" json:"combo,omitempty"`
+	Target string `protobuf:"bytes,8,opt,name=target,proto3" json:"target,omitempty"`
 }
 
 func (x *Player) Reset() {
@@ -83,16 +326,53 @@ func (x *Player) GetHealth() int64 {
 	return 0
 }
 
-type ConnectRequest struct {
+func (x *Player) GetBot() bool {
+	if x != nil {
+		return x.Bot
+	}
+	return false
+}
+
+func (x *Player) GetTeam() int64 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *Player) GetShield() int64 {
+	if x != nil {
+		return x.Shield
+	}
+	return 0
+}
+
+func (x *Player) GetCombo() int64 {
+	if x != nil {
+		return x.Combo
+	}
+	return 0
+}
+
+func (x *Player) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type ClientInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	ProtocolVersion int64    `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Version         string   `protobuf:"bytes,2,opt,name=version,proto3" json:"version,omitempty"`
+	Capability      []string `protobuf:"bytes,3,rep,name=capability,proto3" json:"capability,omitempty"`
 }
 
-func (x *ConnectRequest) Reset() {
-	*x = ConnectRequest{}
+func (x *ClientInfo) Reset() {
+	*x = ClientInfo{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -100,13 +380,13 @@ func (x *ConnectRequest) Reset() {
 	}
 }
 
-func (x *ConnectRequest) String() string {
+func (x *ClientInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectRequest) ProtoMessage() {}
+func (*ClientInfo) ProtoMessage() {}
 
-func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
+func (x *ClientInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -118,29 +398,45 @@ func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
-func (*ConnectRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClientInfo.ProtoReflect.Descriptor instead.
+func (*ClientInfo) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *ConnectRequest) GetName() string {
+func (x *ClientInfo) GetProtocolVersion() int64 {
 	if x != nil {
-		return x.Name
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ClientInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
 	}
 	return ""
 }
 
-type ConnectResponse struct {
+func (x *ClientInfo) GetCapability() []string {
+	if x != nil {
+		return x.Capability
+	}
+	return nil
+}
+
+type Settings struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Id     string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Player []*Player `protobuf:"bytes,3,rep,name=player,proto3" json:"player,omitempty"`
+	InitialHealth int64  `protobuf:"varint,1,opt,name=initial_health,json=initialHealth,proto3" json:"initial_health,omitempty"`
+	DamageModel   string `protobuf:"bytes,2,opt,name=damage_model,json=damageModel,proto3" json:"damage_model,omitempty"`
+	TimeLimit     int64  `protobuf:"varint,3,opt,name=time_limit,json=timeLimit,proto3" json:"time_limit,omitempty"`
+	Dataset       string `protobuf:"bytes,4,opt,name=dataset,proto3" json:"dataset,omitempty"`
 }
 
-func (x *ConnectResponse) Reset() {
-	*x = ConnectResponse{}
+func (x *Settings) Reset() {
+	*x = Settings{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -148,13 +444,13 @@ func (x *ConnectResponse) Reset() {
 	}
 }
 
-func (x *ConnectResponse) String() string {
+func (x *Settings) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectResponse) ProtoMessage() {}
+func (*Settings) ProtoMessage() {}
 
-func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
+func (x *Settings) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -166,33 +462,51 @@ func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
-func (*ConnectResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Settings.ProtoReflect.Descriptor instead.
+func (*Settings) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *ConnectResponse) GetId() string {
+func (x *Settings) GetInitialHealth() int64 {
 	if x != nil {
-		return x.Id
+		return x.InitialHealth
+	}
+	return 0
+}
+
+func (x *Settings) GetDamageModel() string {
+	if x != nil {
+		return x.DamageModel
 	}
 	return ""
 }
 
-func (x *ConnectResponse) GetPlayer() []*Player {
+func (x *Settings) GetTimeLimit() int64 {
 	if x != nil {
-		return x.Player
+		return x.TimeLimit
 	}
-	return nil
+	return 0
 }
 
-type Start struct {
+func (x *Settings) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+type ConnectRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Name   string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Team   int64       `protobuf:"varint,2,opt,name=team,proto3" json:"team,omitempty"`
+	Client *ClientInfo `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *Start) Reset() {
-	*x = Start{}
+func (x *ConnectRequest) Reset() {
+	*x = ConnectRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -200,13 +514,13 @@ func (x *Start) Reset() {
 	}
 }
 
-func (x *Start) String() string {
+func (x *ConnectRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Start) ProtoMessage() {}
+func (*ConnectRequest) ProtoMessage() {}
 
-func (x *Start) ProtoReflect() protoreflect.Message {
+func (x *ConnectRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -218,21 +532,47 @@ func (x *Start) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Start.ProtoReflect.Descriptor instead.
-func (*Start) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectRequest.ProtoReflect.Descriptor instead.
+func (*ConnectRequest) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{3}
 }
 
-type Finish struct {
+func (x *ConnectRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConnectRequest) GetTeam() int64 {
+	if x != nil {
+		return x.Team
+	}
+	return 0
+}
+
+func (x *ConnectRequest) GetClient() *ClientInfo {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
+
+type ConnectResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Winner string `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	Id              string    `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Player          []*Player `protobuf:"bytes,2,rep,name=player,proto3" json:"player,omitempty"`
+	Spectator       bool      `protobuf:"varint,3,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	ProtocolVersion int64     `protobuf:"varint,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	ServerVersion   string    `protobuf:"bytes,5,opt,name=server_version,json=serverVersion,proto3" json:"server_version,omitempty"`
+	Settings        *Settings `protobuf:"bytes,6,opt,name=settings,proto3" json:"settings,omitempty"`
 }
 
-func (x *Finish) Reset() {
-	*x = Finish{}
+func (x *ConnectResponse) Reset() {
+	*x = ConnectResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -240,13 +580,13 @@ func (x *Finish) Reset() {
 	}
 }
 
-func (x *Finish) String() string {
+func (x *ConnectResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Finish) ProtoMessage() {}
+func (*ConnectResponse) ProtoMessage() {}
 
-func (x *Finish) ProtoReflect() protoreflect.Message {
+func (x *ConnectResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -258,28 +598,70 @@ func (x *Finish) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Finish.ProtoReflect.Descriptor instead.
-func (*Finish) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConnectResponse.ProtoReflect.Descriptor instead.
+func (*ConnectResponse) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *Finish) GetWinner() string {
+func (x *ConnectResponse) GetId() string {
 	if x != nil {
-		return x.Winner
+		return x.Id
 	}
 	return ""
 }
 
-type Join struct {
+func (x *ConnectResponse) GetPlayer() []*Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *ConnectResponse) GetSpectator() bool {
+	if x != nil {
+		return x.Spectator
+	}
+	return false
+}
+
+func (x *ConnectResponse) GetProtocolVersion() int64 {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return 0
+}
+
+func (x *ConnectResponse) GetServerVersion() string {
+	if x != nil {
+		return x.ServerVersion
+	}
+	return ""
+}
+
+func (x *ConnectResponse) GetSettings() *Settings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type Room struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+	Id        string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Player    int64  `protobuf:"varint,3,opt,name=player,proto3" json:"player,omitempty"`
+	Capacity  int64  `protobuf:"varint,4,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Started   bool   `protobuf:"varint,5,opt,name=started,proto3" json:"started,omitempty"`
+	Dataset   string `protobuf:"bytes,6,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Spectator int64  `protobuf:"varint,7,opt,name=spectator,proto3" json:"spectator,omitempty"`
+	Teams     int64  `protobuf:"varint,8,opt,name=teams,proto3" json:"teams,omitempty"`
 }
 
-func (x *Join) Reset() {
-	*x = Join{}
+func (x *Room) Reset() {
+	*x = Room{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -287,13 +669,13 @@ func (x *Join) Reset() {
 	}
 }
 
-func (x *Join) String() string {
+func (x *Room) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Join) ProtoMessage() {}
+func (*Room) ProtoMessage() {}
 
-func (x *Join) ProtoReflect() protoreflect.Message {
+func (x *Room) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -305,29 +687,80 @@ func (x *Join) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Join.ProtoReflect.Descriptor instead.
-func (*Join) Descriptor() ([]byte, []int) {
+// Deprecated: Use Room.ProtoReflect.Descriptor instead.
+func (*Room) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *Join) GetPlayer() *Player {
+func (x *Room) GetId() string {
 	if x != nil {
-		return x.Player
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-type Attack struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Text     string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
-	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+func (x *Room) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (x *Attack) Reset() {
-	*x = Attack{}
+func (x *Room) GetPlayer() int64 {
+	if x != nil {
+		return x.Player
+	}
+	return 0
+}
+
+func (x *Room) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *Room) GetStarted() bool {
+	if x != nil {
+		return x.Started
+	}
+	return false
+}
+
+func (x *Room) GetDataset() string {
+	if x != nil {
+		return x.Dataset
+	}
+	return ""
+}
+
+func (x *Room) GetSpectator() int64 {
+	if x != nil {
+		return x.Spectator
+	}
+	return 0
+}
+
+func (x *Room) GetTeams() int64 {
+	if x != nil {
+		return x.Teams
+	}
+	return 0
+}
+
+type CreateRoomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Capacity int64  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	Dataset  string `protobuf:"bytes,3,opt,name=dataset,proto3" json:"dataset,omitempty"`
+	Teams    int64  `protobuf:"varint,4,opt,name=teams,proto3" json:"teams,omitempty"`
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -335,13 +768,13 @@ func (x *Attack) Reset() {
 	}
 }
 
-func (x *Attack) String() string {
+func (x *CreateRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Attack) ProtoMessage() {}
+func (*CreateRoomRequest) ProtoMessage() {}
 
-func (x *Attack) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -353,35 +786,49 @@ func (x *Attack) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Attack.ProtoReflect.Descriptor instead.
-func (*Attack) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *Attack) GetText() string {
+func (x *CreateRoomRequest) GetName() string {
 	if x != nil {
-		return x.Text
+		return x.Name
 	}
 	return ""
 }
 
-func (x *Attack) GetTargetId() string {
+func (x *CreateRoomRequest) GetCapacity() int64 {
 	if x != nil {
-		return x.TargetId
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *CreateRoomRequest) GetDataset() string {
+	if x != nil {
+		return x.Dataset
 	}
 	return ""
 }
 
-type Question struct {
+func (x *CreateRoomRequest) GetTeams() int64 {
+	if x != nil {
+		return x.Teams
+	}
+	return 0
+}
+
+type CreateRoomResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Room *Room `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
 }
 
-func (x *Question) Reset() {
-	*x = Question{}
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -389,13 +836,13 @@ func (x *Question) Reset() {
 	}
 }
 
-func (x *Question) String() string {
+func (x *CreateRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Question) ProtoMessage() {}
+func (*CreateRoomResponse) ProtoMessage() {}
 
-func (x *Question) ProtoReflect() protoreflect.Message {
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -407,29 +854,26 @@ func (x *Question) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Question.ProtoReflect.Descriptor instead.
-func (*Question) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *Question) GetText() string {
+func (x *CreateRoomResponse) GetRoom() *Room {
 	if x != nil {
-		return x.Text
+		return x.Room
 	}
-	return ""
+	return nil
 }
 
-type Damage struct {
+type ListRoomsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Health int64  `protobuf:"varint,2,opt,name=health,proto3" json:"health,omitempty"`
 }
 
-func (x *Damage) Reset() {
-	*x = Damage{}
+func (x *ListRoomsRequest) Reset() {
+	*x = ListRoomsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -437,13 +881,13 @@ func (x *Damage) Reset() {
 	}
 }
 
-func (x *Damage) String() string {
+func (x *ListRoomsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Damage) ProtoMessage() {}
+func (*ListRoomsRequest) ProtoMessage() {}
 
-func (x *Damage) ProtoReflect() protoreflect.Message {
+func (x *ListRoomsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -455,37 +899,21 @@ func (x *Damage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Damage.ProtoReflect.Descriptor instead.
-func (*Damage) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoomsRequest.ProtoReflect.Descriptor instead.
+func (*ListRoomsRequest) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *Damage) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *Damage) GetHealth() int64 {
-	if x != nil {
-		return x.Health
-	}
-	return 0
-}
-
-type Request struct {
+type ListRoomsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//	*Request_Attack
-	Action isRequest_Action `protobuf_oneof:"action"`
+	Room []*Room `protobuf:"bytes,1,rep,name=room,proto3" json:"room,omitempty"`
 }
 
-func (x *Request) Reset() {
-	*x = Request{}
+func (x *ListRoomsResponse) Reset() {
+	*x = ListRoomsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -493,13 +921,13 @@ func (x *Request) Reset() {
 	}
 }
 
-func (x *Request) String() string {
+func (x *ListRoomsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Request) ProtoMessage() {}
+func (*ListRoomsResponse) ProtoMessage() {}
 
-func (x *Request) ProtoReflect() protoreflect.Message {
+func (x *ListRoomsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -511,51 +939,31 @@ func (x *Request) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Request.ProtoReflect.Descriptor instead.
-func (*Request) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRoomsResponse.ProtoReflect.Descriptor instead.
+func (*ListRoomsResponse) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{9}
 }
 
-func (m *Request) GetAction() isRequest_Action {
-	if m != nil {
-		return m.Action
-	}
-	return nil
-}
-
-func (x *Request) GetAttack() *Attack {
-	if x, ok := x.GetAction().(*Request_Attack); ok {
-		return x.Attack
+func (x *ListRoomsResponse) GetRoom() []*Room {
+	if x != nil {
+		return x.Room
 	}
 	return nil
 }
 
-type isRequest_Action interface {
-	isRequest_Action()
-}
-
-type Request_Attack struct {
-	Attack *Attack `protobuf:"bytes,1,opt,name=attack,proto3,oneof"`
-}
-
-func (*Request_Attack) isRequest_Action() {}
-
-type Response struct {
+type JoinRoomRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Event:
-	//	*Response_Question
-	//	*Response_Start
-	//	*Response_Finish
-	//	*Response_Join
-	//	*Response_Damage
-	Event isResponse_Event `protobuf_oneof:"event"`
+	RoomId string      `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name   string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Team   int64       `protobuf:"varint,3,opt,name=team,proto3" json:"team,omitempty"`
+	Client *ClientInfo `protobuf:"bytes,4,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *Response) Reset() {
-	*x = Response{}
+func (x *JoinRoomRequest) Reset() {
+	*x = JoinRoomRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_main_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -563,13 +971,13 @@ func (x *Response) Reset() {
 	}
 }
 
-func (x *Response) String() string {
+func (x *JoinRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Response) ProtoMessage() {}
+func (*JoinRoomRequest) ProtoMessage() {}
 
-func (x *Response) ProtoReflect() protoreflect.Message {
+func (x *JoinRoomRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_main_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -581,187 +989,2300 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Response.ProtoReflect.Descriptor instead.
-func (*Response) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinRoomRequest.ProtoReflect.Descriptor instead.
+func (*JoinRoomRequest) Descriptor() ([]byte, []int) {
 	return file_proto_main_proto_rawDescGZIP(), []int{10}
 }
 
-func (m *Response) GetEvent() isResponse_Event {
-	if m != nil {
-		return m.Event
+func (x *JoinRoomRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
 	}
-	return nil
+	return ""
 }
 
-func (x *Response) GetQuestion() *Question {
-	if x, ok := x.GetEvent().(*Response_Question); ok {
-		return x.Question
+func (x *JoinRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *Response) GetStart() *Start {
-	if x, ok := x.GetEvent().(*Response_Start); ok {
-		return x.Start
+func (x *JoinRoomRequest) GetTeam() int64 {
+	if x != nil {
+		return x.Team
 	}
-	return nil
+	return 0
 }
 
-func (x *Response) GetFinish() *Finish {
-	if x, ok := x.GetEvent().(*Response_Finish); ok {
-		return x.Finish
+func (x *JoinRoomRequest) GetClient() *ClientInfo {
+	if x != nil {
+		return x.Client
 	}
 	return nil
 }
 
-func (x *Response) GetJoin() *Join {
-	if x, ok := x.GetEvent().(*Response_Join); ok {
-		return x.Join
-	}
-	return nil
+type SpectateRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	RoomId string      `protobuf:"bytes,1,opt,name=room_id,json=roomId,proto3" json:"room_id,omitempty"`
+	Name   string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Client *ClientInfo `protobuf:"bytes,3,opt,name=client,proto3" json:"client,omitempty"`
 }
 
-func (x *Response) GetDamage() *Damage {
-	if x, ok := x.GetEvent().(*Response_Damage); ok {
-		return x.Damage
+func (x *SpectateRequest) Reset() {
+	*x = SpectateRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-type isResponse_Event interface {
-	isResponse_Event()
+func (x *SpectateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-type Response_Question struct {
-	Question *Question `protobuf:"bytes,1,opt,name=question,proto3,oneof"`
-}
+func (*SpectateRequest) ProtoMessage() {}
 
-type Response_Start struct {
-	Start *Start `protobuf:"bytes,2,opt,name=start,proto3,oneof"`
+func (x *SpectateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-type Response_Finish struct {
-	Finish *Finish `protobuf:"bytes,3,opt,name=finish,proto3,oneof"`
+// Deprecated: Use SpectateRequest.ProtoReflect.Descriptor instead.
+func (*SpectateRequest) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{11}
 }
 
-type Response_Join struct {
-	Join *Join `protobuf:"bytes,4,opt,name=join,proto3,oneof"`
+func (x *SpectateRequest) GetRoomId() string {
+	if x != nil {
+		return x.RoomId
+	}
+	return ""
 }
 
-type Response_Damage struct {
-	Damage *Damage `protobuf:"bytes,5,opt,name=damage,proto3,oneof"`
+func (x *SpectateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*Response_Question) isResponse_Event() {}
-
-func (*Response_Start) isResponse_Event() {}
-
-func (*Response_Finish) isResponse_Event() {}
+func (x *SpectateRequest) GetClient() *ClientInfo {
+	if x != nil {
+		return x.Client
+	}
+	return nil
+}
 
-func (*Response_Join) isResponse_Event() {}
+type Start struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (*Response_Damage) isResponse_Event() {}
+	Player    []*Player `protobuf:"bytes,1,rep,name=player,proto3" json:"player,omitempty"`
+	Countdown int64     `protobuf:"varint,2,opt,name=countdown,proto3" json:"countdown,omitempty"`
+}
 
-var File_proto_main_proto protoreflect.FileDescriptor
+func (x *Start) Reset() {
+	*x = Start{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
 
-var file_proto_main_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0x44, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
-	0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x22, 0x24, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x42,
-	0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79,
-	0x65, 0x72, 0x22, 0x07, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x20, 0x0a, 0x06, 0x46,
-	0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x22, 0x27, 0x0a,
-	0x04, 0x4a, 0x6f, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06,
-	0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x22, 0x39, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b,
-	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x74, 0x65, 0x78, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49,
-	0x64, 0x22, 0x1e, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78,
-	0x74, 0x22, 0x30, 0x0a, 0x06, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
-	0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x61,
-	0x6c, 0x74, 0x68, 0x22, 0x36, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21,
-	0x0a, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07,
-	0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63,
-	0x6b, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbf, 0x01, 0x0a, 0x08,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x51, 0x75, 0x65,
-	0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x06, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72,
-	0x74, 0x12, 0x21, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x48, 0x00, 0x52, 0x06, 0x66, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x6f, 0x69,
-	0x6e, 0x12, 0x21, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x07, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x61,
-	0x6d, 0x61, 0x67, 0x65, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x32, 0x5b, 0x0a,
-	0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
-	0x12, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x06, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12,
-	0x08, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x79, 0x6f, 0x52, 0x79, 0x75, 0x75, 0x75,
-	0x75, 0x75, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *Start) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-var (
-	file_proto_main_proto_rawDescOnce sync.Once
-	file_proto_main_proto_rawDescData = file_proto_main_proto_rawDesc
-)
+func (*Start) ProtoMessage() {}
 
-func file_proto_main_proto_rawDescGZIP() []byte {
-	file_proto_main_proto_rawDescOnce.Do(func() {
-		file_proto_main_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_main_proto_rawDescData)
+func (x *Start) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Start.ProtoReflect.Descriptor instead.
+func (*Start) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *Start) GetPlayer() []*Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Start) GetCountdown() int64 {
+	if x != nil {
+		return x.Countdown
+	}
+	return 0
+}
+
+type PlayerResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id          string  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string  `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Hit         int64   `protobuf:"varint,3,opt,name=hit,proto3" json:"hit,omitempty"`
+	Miss        int64   `protobuf:"varint,4,opt,name=miss,proto3" json:"miss,omitempty"`
+	Rank        int64   `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	DamageDealt int64   `protobuf:"varint,6,opt,name=damage_dealt,json=damageDealt,proto3" json:"damage_dealt,omitempty"`
+	DamageTaken int64   `protobuf:"varint,7,opt,name=damage_taken,json=damageTaken,proto3" json:"damage_taken,omitempty"`
+	Wpm         float64 `protobuf:"fixed64,8,opt,name=wpm,proto3" json:"wpm,omitempty"`
+	Survived    int64   `protobuf:"varint,9,opt,name=survived,proto3" json:"survived,omitempty"`
+}
+
+func (x *PlayerResult) Reset() {
+	*x = PlayerResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerResult) ProtoMessage() {}
+
+func (x *PlayerResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerResult.ProtoReflect.Descriptor instead.
+func (*PlayerResult) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *PlayerResult) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerResult) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PlayerResult) GetHit() int64 {
+	if x != nil {
+		return x.Hit
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetMiss() int64 {
+	if x != nil {
+		return x.Miss
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetRank() int64 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetDamageDealt() int64 {
+	if x != nil {
+		return x.DamageDealt
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetDamageTaken() int64 {
+	if x != nil {
+		return x.DamageTaken
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetWpm() float64 {
+	if x != nil {
+		return x.Wpm
+	}
+	return 0
+}
+
+func (x *PlayerResult) GetSurvived() int64 {
+	if x != nil {
+		return x.Survived
+	}
+	return 0
+}
+
+type Finish struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Winner string          `protobuf:"bytes,1,opt,name=winner,proto3" json:"winner,omitempty"`
+	Result []*PlayerResult `protobuf:"bytes,2,rep,name=result,proto3" json:"result,omitempty"`
+}
+
+func (x *Finish) Reset() {
+	*x = Finish{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Finish) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Finish) ProtoMessage() {}
+
+func (x *Finish) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Finish.ProtoReflect.Descriptor instead.
+func (*Finish) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Finish) GetWinner() string {
+	if x != nil {
+		return x.Winner
+	}
+	return ""
+}
+
+func (x *Finish) GetResult() []*PlayerResult {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type Join struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Player *Player `protobuf:"bytes,1,opt,name=player,proto3" json:"player,omitempty"`
+}
+
+func (x *Join) Reset() {
+	*x = Join{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Join) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Join) ProtoMessage() {}
+
+func (x *Join) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Join.ProtoReflect.Descriptor instead.
+func (*Join) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *Join) GetPlayer() *Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+type Attack struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text     string     `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	TargetId string     `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Mode     TargetMode `protobuf:"varint,3,opt,name=mode,proto3,enum=TargetMode" json:"mode,omitempty"`
+}
+
+func (x *Attack) Reset() {
+	*x = Attack{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Attack) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Attack) ProtoMessage() {}
+
+func (x *Attack) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Attack.ProtoReflect.Descriptor instead.
+func (*Attack) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *Attack) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Attack) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Attack) GetMode() TargetMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TargetMode_DIRECT
+}
+
+type Error struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Code    ErrorCode `protobuf:"varint,1,opt,name=code,proto3,enum=ErrorCode" json:"code,omitempty"`
+	Message string    `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Error) Reset() {
+	*x = Error{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Error) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Error) ProtoMessage() {}
+
+func (x *Error) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Error.ProtoReflect.Descriptor instead.
+func (*Error) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Error) GetCode() ErrorCode {
+	if x != nil {
+		return x.Code
+	}
+	return ErrorCode_UNKNOWN_ERROR
+}
+
+func (x *Error) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type Aim struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TargetId string     `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	Mode     TargetMode `protobuf:"varint,2,opt,name=mode,proto3,enum=TargetMode" json:"mode,omitempty"`
+}
+
+func (x *Aim) Reset() {
+	*x = Aim{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Aim) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Aim) ProtoMessage() {}
+
+func (x *Aim) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Aim.ProtoReflect.Descriptor instead.
+func (*Aim) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Aim) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+func (x *Aim) GetMode() TargetMode {
+	if x != nil {
+		return x.Mode
+	}
+	return TargetMode_DIRECT
+}
+
+type TargetChanged struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id       string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	TargetId string `protobuf:"bytes,2,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+}
+
+func (x *TargetChanged) Reset() {
+	*x = TargetChanged{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TargetChanged) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TargetChanged) ProtoMessage() {}
+
+func (x *TargetChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TargetChanged.ProtoReflect.Descriptor instead.
+func (*TargetChanged) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *TargetChanged) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *TargetChanged) GetTargetId() string {
+	if x != nil {
+		return x.TargetId
+	}
+	return ""
+}
+
+type Question struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text    string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+	Reading string `protobuf:"bytes,2,opt,name=reading,proto3" json:"reading,omitempty"`
+	Damage  int64  `protobuf:"varint,3,opt,name=damage,proto3" json:"damage,omitempty"`
+	Id      string `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Effect  Effect `protobuf:"varint,5,opt,name=effect,proto3,enum=Effect" json:"effect,omitempty"`
+}
+
+func (x *Question) Reset() {
+	*x = Question{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Question) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Question) ProtoMessage() {}
+
+func (x *Question) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Question.ProtoReflect.Descriptor instead.
+func (*Question) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Question) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Question) GetReading() string {
+	if x != nil {
+		return x.Reading
+	}
+	return ""
+}
+
+func (x *Question) GetDamage() int64 {
+	if x != nil {
+		return x.Damage
+	}
+	return 0
+}
+
+func (x *Question) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Question) GetEffect() Effect {
+	if x != nil {
+		return x.Effect
+	}
+	return Effect_NORMAL
+}
+
+type Damage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Health int64  `protobuf:"varint,2,opt,name=health,proto3" json:"health,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Damage) Reset() {
+	*x = Damage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Damage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Damage) ProtoMessage() {}
+
+func (x *Damage) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Damage.ProtoReflect.Descriptor instead.
+func (*Damage) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Damage) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Damage) GetHealth() int64 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *Damage) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Heal struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Health int64  `protobuf:"varint,2,opt,name=health,proto3" json:"health,omitempty"`
+	Amount int64  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *Heal) Reset() {
+	*x = Heal{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Heal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Heal) ProtoMessage() {}
+
+func (x *Heal) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Heal.ProtoReflect.Descriptor instead.
+func (*Heal) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Heal) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Heal) GetHealth() int64 {
+	if x != nil {
+		return x.Health
+	}
+	return 0
+}
+
+func (x *Heal) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+type Shield struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id     string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Shield int64  `protobuf:"varint,2,opt,name=shield,proto3" json:"shield,omitempty"`
+}
+
+func (x *Shield) Reset() {
+	*x = Shield{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Shield) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Shield) ProtoMessage() {}
+
+func (x *Shield) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Shield.ProtoReflect.Descriptor instead.
+func (*Shield) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Shield) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Shield) GetShield() int64 {
+	if x != nil {
+		return x.Shield
+	}
+	return 0
+}
+
+type Clock struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Remaining   int64 `protobuf:"varint,1,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	SuddenDeath bool  `protobuf:"varint,2,opt,name=sudden_death,json=suddenDeath,proto3" json:"sudden_death,omitempty"`
+	Multiplier  int64 `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *Clock) Reset() {
+	*x = Clock{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clock) ProtoMessage() {}
+
+func (x *Clock) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clock.ProtoReflect.Descriptor instead.
+func (*Clock) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Clock) GetRemaining() int64 {
+	if x != nil {
+		return x.Remaining
+	}
+	return 0
+}
+
+func (x *Clock) GetSuddenDeath() bool {
+	if x != nil {
+		return x.SuddenDeath
+	}
+	return false
+}
+
+func (x *Clock) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type Combo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Combo      int64  `protobuf:"varint,2,opt,name=combo,proto3" json:"combo,omitempty"`
+	Multiplier int64  `protobuf:"varint,3,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *Combo) Reset() {
+	*x = Combo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Combo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Combo) ProtoMessage() {}
+
+func (x *Combo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Combo.ProtoReflect.Descriptor instead.
+func (*Combo) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Combo) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Combo) GetCombo() int64 {
+	if x != nil {
+		return x.Combo
+	}
+	return 0
+}
+
+func (x *Combo) GetMultiplier() int64 {
+	if x != nil {
+		return x.Multiplier
+	}
+	return 0
+}
+
+type State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase Phase `protobuf:"varint,1,opt,name=phase,proto3,enum=Phase" json:"phase,omitempty"`
+}
+
+func (x *State) Reset() {
+	*x = State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*State) ProtoMessage() {}
+
+func (x *State) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use State.ProtoReflect.Descriptor instead.
+func (*State) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *State) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_WAITING
+}
+
+type Progress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Text string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
+}
+
+func (x *Progress) Reset() {
+	*x = Progress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Progress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Progress) ProtoMessage() {}
+
+func (x *Progress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Progress.ProtoReflect.Descriptor instead.
+func (*Progress) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Progress) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type PlayerProgress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id      string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Percent int64  `protobuf:"varint,2,opt,name=percent,proto3" json:"percent,omitempty"`
+}
+
+func (x *PlayerProgress) Reset() {
+	*x = PlayerProgress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PlayerProgress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PlayerProgress) ProtoMessage() {}
+
+func (x *PlayerProgress) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PlayerProgress.ProtoReflect.Descriptor instead.
+func (*PlayerProgress) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *PlayerProgress) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PlayerProgress) GetPercent() int64 {
+	if x != nil {
+		return x.Percent
+	}
+	return 0
+}
+
+type AttackResult struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hit     bool   `protobuf:"varint,1,opt,name=hit,proto3" json:"hit,omitempty"`
+	Penalty string `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty,omitempty"`
+	Lockout int64  `protobuf:"varint,3,opt,name=lockout,proto3" json:"lockout,omitempty"`
+}
+
+func (x *AttackResult) Reset() {
+	*x = AttackResult{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AttackResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttackResult) ProtoMessage() {}
+
+func (x *AttackResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttackResult.ProtoReflect.Descriptor instead.
+func (*AttackResult) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *AttackResult) GetHit() bool {
+	if x != nil {
+		return x.Hit
+	}
+	return false
+}
+
+func (x *AttackResult) GetPenalty() string {
+	if x != nil {
+		return x.Penalty
+	}
+	return ""
+}
+
+func (x *AttackResult) GetLockout() int64 {
+	if x != nil {
+		return x.Lockout
+	}
+	return 0
+}
+
+type Eliminated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Eliminated) Reset() {
+	*x = Eliminated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Eliminated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Eliminated) ProtoMessage() {}
+
+func (x *Eliminated) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Eliminated.ProtoReflect.Descriptor instead.
+func (*Eliminated) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *Eliminated) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Leave struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *Leave) Reset() {
+	*x = Leave{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Leave) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Leave) ProtoMessage() {}
+
+func (x *Leave) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Leave.ProtoReflect.Descriptor instead.
+func (*Leave) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *Leave) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type Rematch struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *Rematch) Reset() {
+	*x = Rematch{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rematch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rematch) ProtoMessage() {}
+
+func (x *Rematch) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rematch.ProtoReflect.Descriptor instead.
+func (*Rematch) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{32}
+}
+
+type RematchVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vote     int64 `protobuf:"varint,1,opt,name=vote,proto3" json:"vote,omitempty"`
+	Required int64 `protobuf:"varint,2,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *RematchVote) Reset() {
+	*x = RematchVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RematchVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RematchVote) ProtoMessage() {}
+
+func (x *RematchVote) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RematchVote.ProtoReflect.Descriptor instead.
+func (*RematchVote) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *RematchVote) GetVote() int64 {
+	if x != nil {
+		return x.Vote
+	}
+	return 0
+}
+
+func (x *RematchVote) GetRequired() int64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+type Snapshot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Phase    Phase       `protobuf:"varint,1,opt,name=phase,proto3,enum=Phase" json:"phase,omitempty"`
+	Player   []*Player   `protobuf:"bytes,2,rep,name=player,proto3" json:"player,omitempty"`
+	Question *Question   `protobuf:"bytes,3,opt,name=question,proto3" json:"question,omitempty"`
+	Word     []*Question `protobuf:"bytes,4,rep,name=word,proto3" json:"word,omitempty"`
+	Clock    *Clock      `protobuf:"bytes,5,opt,name=clock,proto3" json:"clock,omitempty"`
+}
+
+func (x *Snapshot) Reset() {
+	*x = Snapshot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Snapshot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Snapshot) ProtoMessage() {}
+
+func (x *Snapshot) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Snapshot.ProtoReflect.Descriptor instead.
+func (*Snapshot) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *Snapshot) GetPhase() Phase {
+	if x != nil {
+		return x.Phase
+	}
+	return Phase_WAITING
+}
+
+func (x *Snapshot) GetPlayer() []*Player {
+	if x != nil {
+		return x.Player
+	}
+	return nil
+}
+
+func (x *Snapshot) GetQuestion() *Question {
+	if x != nil {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *Snapshot) GetWord() []*Question {
+	if x != nil {
+		return x.Word
+	}
+	return nil
+}
+
+func (x *Snapshot) GetClock() *Clock {
+	if x != nil {
+		return x.Clock
+	}
+	return nil
+}
+
+type Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Action:
+	//	*Request_Attack
+	//	*Request_Rematch
+	//	*Request_Progress
+	//	*Request_Aim
+	Action isRequest_Action `protobuf_oneof:"action"`
+}
+
+func (x *Request) Reset() {
+	*x = Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Request) ProtoMessage() {}
+
+func (x *Request) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Request.ProtoReflect.Descriptor instead.
+func (*Request) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{35}
+}
+
+func (m *Request) GetAction() isRequest_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (x *Request) GetAttack() *Attack {
+	if x, ok := x.GetAction().(*Request_Attack); ok {
+		return x.Attack
+	}
+	return nil
+}
+
+func (x *Request) GetRematch() *Rematch {
+	if x, ok := x.GetAction().(*Request_Rematch); ok {
+		return x.Rematch
+	}
+	return nil
+}
+
+func (x *Request) GetProgress() *Progress {
+	if x, ok := x.GetAction().(*Request_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Request) GetAim() *Aim {
+	if x, ok := x.GetAction().(*Request_Aim); ok {
+		return x.Aim
+	}
+	return nil
+}
+
+type isRequest_Action interface {
+	isRequest_Action()
+}
+
+type Request_Attack struct {
+	Attack *Attack `protobuf:"bytes,1,opt,name=attack,proto3,oneof"`
+}
+
+type Request_Rematch struct {
+	Rematch *Rematch `protobuf:"bytes,2,opt,name=rematch,proto3,oneof"`
+}
+
+type Request_Progress struct {
+	Progress *Progress `protobuf:"bytes,3,opt,name=progress,proto3,oneof"`
+}
+
+type Request_Aim struct {
+	Aim *Aim `protobuf:"bytes,4,opt,name=aim,proto3,oneof"`
+}
+
+func (*Request_Attack) isRequest_Action() {}
+
+func (*Request_Rematch) isRequest_Action() {}
+
+func (*Request_Progress) isRequest_Action() {}
+
+func (*Request_Aim) isRequest_Action() {}
+
+type Response struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Event:
+	//	*Response_Question
+	//	*Response_Start
+	//	*Response_Finish
+	//	*Response_Join
+	//	*Response_Damage
+	//	*Response_RematchVote
+	//	*Response_State
+	//	*Response_Progress
+	//	*Response_AttackResult
+	//	*Response_Eliminated
+	//	*Response_Leave
+	//	*Response_Snapshot
+	//	*Response_Heal
+	//	*Response_Shield
+	//	*Response_Combo
+	//	*Response_Clock
+	//	*Response_TargetChanged
+	//	*Response_Error
+	Event isResponse_Event `protobuf_oneof:"event"`
+}
+
+func (x *Response) Reset() {
+	*x = Response{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_main_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Response) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Response) ProtoMessage() {}
+
+func (x *Response) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_main_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Response.ProtoReflect.Descriptor instead.
+func (*Response) Descriptor() ([]byte, []int) {
+	return file_proto_main_proto_rawDescGZIP(), []int{36}
+}
+
+func (m *Response) GetEvent() isResponse_Event {
+	if m != nil {
+		return m.Event
+	}
+	return nil
+}
+
+func (x *Response) GetQuestion() *Question {
+	if x, ok := x.GetEvent().(*Response_Question); ok {
+		return x.Question
+	}
+	return nil
+}
+
+func (x *Response) GetStart() *Start {
+	if x, ok := x.GetEvent().(*Response_Start); ok {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Response) GetFinish() *Finish {
+	if x, ok := x.GetEvent().(*Response_Finish); ok {
+		return x.Finish
+	}
+	return nil
+}
+
+func (x *Response) GetJoin() *Join {
+	if x, ok := x.GetEvent().(*Response_Join); ok {
+		return x.Join
+	}
+	return nil
+}
+
+func (x *Response) GetDamage() *Damage {
+	if x, ok := x.GetEvent().(*Response_Damage); ok {
+		return x.Damage
+	}
+	return nil
+}
+
+func (x *Response) GetRematchVote() *RematchVote {
+	if x, ok := x.GetEvent().(*Response_RematchVote); ok {
+		return x.RematchVote
+	}
+	return nil
+}
+
+func (x *Response) GetState() *State {
+	if x, ok := x.GetEvent().(*Response_State); ok {
+		return x.State
+	}
+	return nil
+}
+
+func (x *Response) GetProgress() *PlayerProgress {
+	if x, ok := x.GetEvent().(*Response_Progress); ok {
+		return x.Progress
+	}
+	return nil
+}
+
+func (x *Response) GetAttackResult() *AttackResult {
+	if x, ok := x.GetEvent().(*Response_AttackResult); ok {
+		return x.AttackResult
+	}
+	return nil
+}
+
+func (x *Response) GetEliminated() *Eliminated {
+	if x, ok := x.GetEvent().(*Response_Eliminated); ok {
+		return x.Eliminated
+	}
+	return nil
+}
+
+func (x *Response) GetLeave() *Leave {
+	if x, ok := x.GetEvent().(*Response_Leave); ok {
+		return x.Leave
+	}
+	return nil
+}
+
+func (x *Response) GetSnapshot() *Snapshot {
+	if x, ok := x.GetEvent().(*Response_Snapshot); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Response) GetHeal() *Heal {
+	if x, ok := x.GetEvent().(*Response_Heal); ok {
+		return x.Heal
+	}
+	return nil
+}
+
+func (x *Response) GetShield() *Shield {
+	if x, ok := x.GetEvent().(*Response_Shield); ok {
+		return x.Shield
+	}
+	return nil
+}
+
+func (x *Response) GetCombo() *Combo {
+	if x, ok := x.GetEvent().(*Response_Combo); ok {
+		return x.Combo
+	}
+	return nil
+}
+
+func (x *Response) GetClock() *Clock {
+	if x, ok := x.GetEvent().(*Response_Clock); ok {
+		return x.Clock
+	}
+	return nil
+}
+
+func (x *Response) GetTargetChanged() *TargetChanged {
+	if x, ok := x.GetEvent().(*Response_TargetChanged); ok {
+		return x.TargetChanged
+	}
+	return nil
+}
+
+func (x *Response) GetError() *Error {
+	if x, ok := x.GetEvent().(*Response_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+type isResponse_Event interface {
+	isResponse_Event()
+}
+
+type Response_Question struct {
+	Question *Question `protobuf:"bytes,1,opt,name=question,proto3,oneof"`
+}
+
+type Response_Start struct {
+	Start *Start `protobuf:"bytes,2,opt,name=start,proto3,oneof"`
+}
+
+type Response_Finish struct {
+	Finish *Finish `protobuf:"bytes,3,opt,name=finish,proto3,oneof"`
+}
+
+type Response_Join struct {
+	Join *Join `protobuf:"bytes,4,opt,name=join,proto3,oneof"`
+}
+
+type Response_Damage struct {
+	Damage *Damage `protobuf:"bytes,5,opt,name=damage,proto3,oneof"`
+}
+
+type Response_RematchVote struct {
+	RematchVote *RematchVote `protobuf:"bytes,6,opt,name=rematch_vote,json=rematchVote,proto3,oneof"`
+}
+
+type Response_State struct {
+	State *State `protobuf:"bytes,7,opt,name=state,proto3,oneof"`
+}
+
+type Response_Progress struct {
+	Progress *PlayerProgress `protobuf:"bytes,8,opt,name=progress,proto3,oneof"`
+}
+
+type Response_AttackResult struct {
+	AttackResult *AttackResult `protobuf:"bytes,9,opt,name=attack_result,json=attackResult,proto3,oneof"`
+}
+
+type Response_Eliminated struct {
+	Eliminated *Eliminated `protobuf:"bytes,10,opt,name=eliminated,proto3,oneof"`
+}
+
+type Response_Leave struct {
+	Leave *Leave `protobuf:"bytes,11,opt,name=leave,proto3,oneof"`
+}
+
+type Response_Snapshot struct {
+	Snapshot *Snapshot `protobuf:"bytes,12,opt,name=snapshot,proto3,oneof"`
+}
+
+type Response_Heal struct {
+	Heal *Heal `protobuf:"bytes,13,opt,name=heal,proto3,oneof"`
+}
+
+type Response_Shield struct {
+	Shield *Shield `protobuf:"bytes,14,opt,name=shield,proto3,oneof"`
+}
+
+type Response_Combo struct {
+	Combo *Combo `protobuf:"bytes,15,opt,name=combo,proto3,oneof"`
+}
+
+type Response_Clock struct {
+	Clock *Clock `protobuf:"bytes,16,opt,name=clock,proto3,oneof"`
+}
+
+type Response_TargetChanged struct {
+	TargetChanged *TargetChanged `protobuf:"bytes,17,opt,name=target_changed,json=targetChanged,proto3,oneof"`
+}
+
+type Response_Error struct {
+	Error *Error `protobuf:"bytes,18,opt,name=error,proto3,oneof"`
+}
+
+func (*Response_Question) isResponse_Event() {}
+
+func (*Response_Start) isResponse_Event() {}
+
+func (*Response_Finish) isResponse_Event() {}
+
+func (*Response_Join) isResponse_Event() {}
+
+func (*Response_Damage) isResponse_Event() {}
+
+func (*Response_RematchVote) isResponse_Event() {}
+
+func (*Response_State) isResponse_Event() {}
+
+func (*Response_Progress) isResponse_Event() {}
+
+func (*Response_AttackResult) isResponse_Event() {}
+
+func (*Response_Eliminated) isResponse_Event() {}
+
+func (*Response_Leave) isResponse_Event() {}
+
+func (*Response_Snapshot) isResponse_Event() {}
+
+func (*Response_Heal) isResponse_Event() {}
+
+func (*Response_Shield) isResponse_Event() {}
+
+func (*Response_Combo) isResponse_Event() {}
+
+func (*Response_Clock) isResponse_Event() {}
+
+func (*Response_TargetChanged) isResponse_Event() {}
+
+func (*Response_Error) isResponse_Event() {}
+
+var File_proto_main_proto protoreflect.FileDescriptor
+
+var file_proto_main_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xb0, 0x01, 0x0a, 0x06, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x10, 0x0a, 0x03, 0x62, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x62, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x61, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x71, 0x0a, 0x0a, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x70, 0x61,
+	0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61,
+	0x70, 0x61, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x22, 0x8d, 0x01, 0x0a, 0x08, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x5f, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x21, 0x0a, 0x0c,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x22, 0x5d, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65,
+	0x61, 0x6d, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22, 0xd9, 0x01, 0x0a, 0x0f, 0x43, 0x6f, 0x6e, 0x6e,
+	0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x70,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x6c,
+	0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09,
+	0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x73, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x29, 0x0a, 0x10, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f,
+	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09,
+	0x2e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69,
+	0x6e, 0x67, 0x73, 0x22, 0xc6, 0x01, 0x0a, 0x04, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61,
+	0x63, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x18,
+	0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x70, 0x65, 0x63,
+	0x74, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x73, 0x70, 0x65,
+	0x63, 0x74, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d, 0x73, 0x22, 0x73, 0x0a, 0x11,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x64, 0x61, 0x74, 0x61, 0x73, 0x65, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x65, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x74, 0x65, 0x61, 0x6d,
+	0x73, 0x22, 0x2f, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x04, 0x72, 0x6f,
+	0x6f, 0x6d, 0x22, 0x12, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f,
+	0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x19, 0x0a, 0x04, 0x72,
+	0x6f, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x52, 0x6f, 0x6f, 0x6d,
+	0x52, 0x04, 0x72, 0x6f, 0x6f, 0x6d, 0x22, 0x77, 0x0a, 0x0f, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f,
+	0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f,
+	0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d,
+	0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x65, 0x61, 0x6d, 0x12, 0x23, 0x0a, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x43, 0x6c, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x22,
+	0x63, 0x0a, 0x0f, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x72, 0x6f, 0x6f, 0x6d, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x6f, 0x6f, 0x6d, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x23, 0x0a, 0x06, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0b, 0x2e, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x06, 0x63, 0x6c,
+	0x69, 0x65, 0x6e, 0x74, 0x22, 0x46, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1f, 0x0a,
+	0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e,
+	0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x1c,
+	0x0a, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x09, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x22, 0xe0, 0x01, 0x0a,
+	0x0c, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x68, 0x69, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x69, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x04, 0x6d, 0x69, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x21, 0x0a, 0x0c, 0x64,
+	0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x65, 0x61, 0x6c, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x44, 0x65, 0x61, 0x6c, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x61, 0x6b, 0x65, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x54, 0x61, 0x6b, 0x65,
+	0x6e, 0x12, 0x10, 0x0a, 0x03, 0x77, 0x70, 0x6d, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03,
+	0x77, 0x70, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73, 0x75, 0x72, 0x76, 0x69, 0x76, 0x65, 0x64, 0x22,
+	0x47, 0x0a, 0x06, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x6e, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x12, 0x25, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0d, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x27, 0x0a, 0x04, 0x4a, 0x6f, 0x69, 0x6e,
+	0x12, 0x1f, 0x0a, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x07, 0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65,
+	0x72, 0x22, 0x5a, 0x0a, 0x06, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x12, 0x0a, 0x04, 0x74,
+	0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04,
+	0x6d, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x41, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1e, 0x0a, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x0a, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65,
+	0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x22, 0x43, 0x0a, 0x03, 0x41, 0x69, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x52,
+	0x04, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x3c, 0x0a, 0x0d, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x49, 0x64, 0x22, 0x81, 0x01, 0x0a, 0x08, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x74, 0x65, 0x78, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x64, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06,
+	0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1f, 0x0a, 0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x07, 0x2e, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x52,
+	0x06, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x22, 0x48, 0x0a, 0x06, 0x44, 0x61, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x22, 0x46, 0x0a, 0x04, 0x48, 0x65, 0x61, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61,
+	0x6c, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x30, 0x0a, 0x06, 0x53, 0x68, 0x69,
+	0x65, 0x6c, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x68, 0x0a, 0x05, 0x43,
+	0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e,
+	0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69,
+	0x6e, 0x67, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x75, 0x64, 0x64, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x61,
+	0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x75, 0x64, 0x64, 0x65, 0x6e,
+	0x44, 0x65, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c,
+	0x69, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69,
+	0x70, 0x6c, 0x69, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x05, 0x43, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69,
+	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70,
+	0x6c, 0x69, 0x65, 0x72, 0x22, 0x25, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1c, 0x0a,
+	0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x50,
+	0x68, 0x61, 0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x22, 0x1e, 0x0a, 0x08, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x22, 0x3a, 0x0a, 0x0e, 0x50,
+	0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x54, 0x0a, 0x0c, 0x41, 0x74, 0x74, 0x61, 0x63,
+	0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x69, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x03, 0x68, 0x69, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x65, 0x6e,
+	0x61, 0x6c, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x65, 0x6e, 0x61,
+	0x6c, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x22, 0x1c, 0x0a,
+	0x0a, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x17, 0x0a, 0x05, 0x4c,
+	0x65, 0x61, 0x76, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x02, 0x69, 0x64, 0x22, 0x09, 0x0a, 0x07, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x22,
+	0x3d, 0x0a, 0x0b, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x76, 0x6f,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0xad,
+	0x01, 0x0a, 0x08, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1c, 0x0a, 0x05, 0x70,
+	0x68, 0x61, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x06, 0x2e, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x05, 0x70, 0x68, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x0a, 0x06, 0x70, 0x6c, 0x61,
+	0x79, 0x65, 0x72, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x50, 0x6c, 0x61, 0x79,
+	0x65, 0x72, 0x52, 0x06, 0x70, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x51,
+	0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1d, 0x0a, 0x04, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x04, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x1c, 0x0a, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x9f,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x61, 0x74,
+	0x74, 0x61, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x41, 0x74, 0x74,
+	0x61, 0x63, 0x6b, 0x48, 0x00, 0x52, 0x06, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x24, 0x0a,
+	0x07, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x08,
+	0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x48, 0x00, 0x52, 0x07, 0x72, 0x65, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x27, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x09, 0x2e, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
+	0x48, 0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x03,
+	0x61, 0x69, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x04, 0x2e, 0x41, 0x69, 0x6d, 0x48,
+	0x00, 0x52, 0x03, 0x61, 0x69, 0x6d, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0xc8, 0x05, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a,
+	0x08, 0x71, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x09, 0x2e, 0x51, 0x75, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x08, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x74, 0x48, 0x00, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x21, 0x0a, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x48,
+	0x00, 0x52, 0x06, 0x66, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x04, 0x6a, 0x6f, 0x69,
+	0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x48, 0x00,
+	0x52, 0x04, 0x6a, 0x6f, 0x69, 0x6e, 0x12, 0x21, 0x0a, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x44, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x48,
+	0x00, 0x52, 0x06, 0x64, 0x61, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x31, 0x0a, 0x0c, 0x72, 0x65, 0x6d,
+	0x61, 0x74, 0x63, 0x68, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x52, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x48, 0x00, 0x52,
+	0x0b, 0x72, 0x65, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1e, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x48, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x08,
+	0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f,
+	0x2e, 0x50, 0x6c, 0x61, 0x79, 0x65, 0x72, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x48,
+	0x00, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x0d, 0x61,
+	0x74, 0x74, 0x61, 0x63, 0x6b, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x41, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x48, 0x00, 0x52, 0x0c, 0x61, 0x74, 0x74, 0x61, 0x63, 0x6b, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x12, 0x2d, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x45, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64,
+	0x12, 0x1e, 0x0a, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x06, 0x2e, 0x4c, 0x65, 0x61, 0x76, 0x65, 0x48, 0x00, 0x52, 0x05, 0x6c, 0x65, 0x61, 0x76, 0x65,
+	0x12, 0x27, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x09, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52,
+	0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x68, 0x65, 0x61,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x05, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x65, 0x61, 0x6c, 0x12, 0x21, 0x0a, 0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x07, 0x2e, 0x53, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x48,
+	0x00, 0x52, 0x06, 0x73, 0x68, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6f, 0x6d,
+	0x62, 0x6f, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x6f,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x6d, 0x62, 0x6f, 0x12, 0x1e, 0x0a, 0x05, 0x63, 0x6c, 0x6f,
+	0x63, 0x6b, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x06, 0x2e, 0x43, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x37, 0x0a, 0x0e, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x64, 0x48, 0x00, 0x52, 0x0d, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x64, 0x12, 0x1e, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x12, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x06, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x48, 0x00, 0x52, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x2a, 0xed, 0x01, 0x0a, 0x09,
+	0x45, 0x72, 0x72, 0x6f, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x11, 0x0a, 0x0d, 0x55, 0x4e, 0x4b,
+	0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x45, 0x52, 0x52, 0x4f, 0x52, 0x10, 0x00, 0x12, 0x0f, 0x0a, 0x0b,
+	0x53, 0x45, 0x52, 0x56, 0x45, 0x52, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x10, 0x01, 0x12, 0x10, 0x0a,
+	0x0c, 0x47, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x52, 0x54, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x12, 0x0a, 0x0e, 0x49, 0x4e, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x54, 0x41, 0x52, 0x47, 0x45,
+	0x54, 0x10, 0x03, 0x12, 0x11, 0x0a, 0x0d, 0x4e, 0x4f, 0x54, 0x5f, 0x59, 0x4f, 0x55, 0x52, 0x5f,
+	0x54, 0x55, 0x52, 0x4e, 0x10, 0x04, 0x12, 0x10, 0x0a, 0x0c, 0x52, 0x41, 0x54, 0x45, 0x5f, 0x4c,
+	0x49, 0x4d, 0x49, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x41,
+	0x4c, 0x49, 0x44, 0x5f, 0x41, 0x52, 0x47, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x06, 0x12, 0x0d,
+	0x0a, 0x09, 0x4e, 0x4f, 0x54, 0x5f, 0x46, 0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x18, 0x0a,
+	0x14, 0x49, 0x4e, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x5f, 0x56, 0x45,
+	0x52, 0x53, 0x49, 0x4f, 0x4e, 0x10, 0x08, 0x12, 0x0d, 0x0a, 0x09, 0x54, 0x49, 0x4d, 0x45, 0x44,
+	0x5f, 0x4f, 0x55, 0x54, 0x10, 0x09, 0x12, 0x0e, 0x0a, 0x0a, 0x51, 0x55, 0x45, 0x55, 0x45, 0x5f,
+	0x46, 0x55, 0x4c, 0x4c, 0x10, 0x0a, 0x12, 0x13, 0x0a, 0x0f, 0x43, 0x4f, 0x4e, 0x4e, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4c, 0x4f, 0x53, 0x54, 0x10, 0x0b, 0x2a, 0x66, 0x0a, 0x0a, 0x54,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0a, 0x0a, 0x06, 0x44, 0x49, 0x52,
+	0x45, 0x43, 0x54, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x41, 0x4e, 0x44, 0x4f, 0x4d, 0x10,
+	0x01, 0x12, 0x11, 0x0a, 0x0d, 0x4c, 0x4f, 0x57, 0x45, 0x53, 0x54, 0x5f, 0x48, 0x45, 0x41, 0x4c,
+	0x54, 0x48, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x48, 0x49, 0x47, 0x48, 0x45, 0x53, 0x54, 0x5f,
+	0x48, 0x45, 0x41, 0x4c, 0x54, 0x48, 0x10, 0x03, 0x12, 0x0b, 0x0a, 0x07, 0x52, 0x45, 0x56, 0x45,
+	0x4e, 0x47, 0x45, 0x10, 0x04, 0x12, 0x0c, 0x0a, 0x08, 0x41, 0x54, 0x54, 0x41, 0x43, 0x4b, 0x45,
+	0x52, 0x10, 0x05, 0x2a, 0x3d, 0x0a, 0x06, 0x45, 0x66, 0x66, 0x65, 0x63, 0x74, 0x12, 0x0a, 0x0a,
+	0x06, 0x4e, 0x4f, 0x52, 0x4d, 0x41, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x48, 0x45, 0x41,
+	0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x48, 0x49, 0x45, 0x4c, 0x44, 0x10, 0x02, 0x12,
+	0x11, 0x0a, 0x0d, 0x44, 0x4f, 0x55, 0x42, 0x4c, 0x45, 0x5f, 0x44, 0x41, 0x4d, 0x41, 0x47, 0x45,
+	0x10, 0x03, 0x2a, 0x3e, 0x0a, 0x05, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x57,
+	0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x0d, 0x0a, 0x09, 0x43, 0x4f, 0x55, 0x4e,
+	0x54, 0x44, 0x4f, 0x57, 0x4e, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x50, 0x4c, 0x41, 0x59, 0x49,
+	0x4e, 0x47, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44,
+	0x10, 0x03, 0x32, 0xae, 0x02, 0x0a, 0x04, 0x47, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x12, 0x0f, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x23, 0x0a, 0x06, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x08, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x09, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x28, 0x01, 0x30, 0x01,
+	0x12, 0x37, 0x0a, 0x0a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x12,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x13, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x34, 0x0a, 0x09, 0x4c, 0x69, 0x73,
+	0x74, 0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x12, 0x11, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x6f, 0x6f,
+	0x6d, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x6f, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x30, 0x0a, 0x08, 0x4a, 0x6f, 0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x12, 0x10, 0x2e, 0x4a, 0x6f,
+	0x69, 0x6e, 0x52, 0x6f, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x30, 0x0a, 0x08, 0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x2e,
+	0x53, 0x70, 0x65, 0x63, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x10, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x42, 0x22, 0x5a, 0x20, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x79, 0x6f, 0x52, 0x79, 0x75, 0x75, 0x75, 0x75, 0x75, 0x2f, 0x74, 0x79, 0x70, 0x65,
+	0x78, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_main_proto_rawDescOnce sync.Once
+	file_proto_main_proto_rawDescData = file_proto_main_proto_rawDesc
+)
+
+func file_proto_main_proto_rawDescGZIP() []byte {
+	file_proto_main_proto_rawDescOnce.Do(func() {
+		file_proto_main_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_main_proto_rawDescData)
 	})
 	return file_proto_main_proto_rawDescData
 }
 
-var file_proto_main_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_proto_main_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_proto_main_proto_msgTypes = make([]protoimpl.MessageInfo, 37)
 var file_proto_main_proto_goTypes = []interface{}{
-	(*Player)(nil),          // 0: Player
-	(*ConnectRequest)(nil),  // 1: ConnectRequest
-	(*ConnectResponse)(nil), // 2: ConnectResponse
-	(*Start)(nil),           // 3: Start
-	(*Finish)(nil),          // 4: Finish
-	(*Join)(nil),            // 5: Join
-	(*Attack)(nil),          // 6: Attack
-	(*Question)(nil),        // 7: Question
-	(*Damage)(nil),          // 8: Damage
-	(*Request)(nil),         // 9: Request
-	(*Response)(nil),        // 10: Response
+	(ErrorCode)(0),             // 0: ErrorCode
+	(TargetMode)(0),            // 1: TargetMode
+	(Effect)(0),                // 2: Effect
+	(Phase)(0),                 // 3: Phase
+	(*Player)(nil),             // 4: Player
+	(*ClientInfo)(nil),         // 5: ClientInfo
+	(*Settings)(nil),           // 6: Settings
+	(*ConnectRequest)(nil),     // 7: ConnectRequest
+	(*ConnectResponse)(nil),    // 8: ConnectResponse
+	(*Room)(nil),               // 9: Room
+	(*CreateRoomRequest)(nil),  // 10: CreateRoomRequest
+	(*CreateRoomResponse)(nil), // 11: CreateRoomResponse
+	(*ListRoomsRequest)(nil),   // 12: ListRoomsRequest
+	(*ListRoomsResponse)(nil),  // 13: ListRoomsResponse
+	(*JoinRoomRequest)(nil),    // 14: JoinRoomRequest
+	(*SpectateRequest)(nil),    // 15: SpectateRequest
+	(*Start)(nil),              // 16: Start
+	(*PlayerResult)(nil),       // 17: PlayerResult
+	(*Finish)(nil),             // 18: Finish
+	(*Join)(nil),               // 19: Join
+	(*Attack)(nil),             // 20: Attack
+	(*Error)(nil),              // 21: Error
+	(*Aim)(nil),                // 22: Aim
+	(*TargetChanged)(nil),      // 23: TargetChanged
+	(*Question)(nil),           // 24: Question
+	(*Damage)(nil),             // 25: Damage
+	(*Heal)(nil),               // 26: Heal
+	(*Shield)(nil),             // 27: Shield
+	(*Clock)(nil),              // 28: Clock
+	(*Combo)(nil),              // 29: Combo
+	(*State)(nil),              // 30: State
+	(*Progress)(nil),           // 31: Progress
+	(*PlayerProgress)(nil),     // 32: PlayerProgress
+	(*AttackResult)(nil),       // 33: AttackResult
+	(*Eliminated)(nil),         // 34: Eliminated
+	(*Leave)(nil),              // 35: Leave
+	(*Rematch)(nil),            // 36: Rematch
+	(*RematchVote)(nil),        // 37: RematchVote
+	(*Snapshot)(nil),           // 38: Snapshot
+	(*Request)(nil),            // 39: Request
+	(*Response)(nil),           // 40: Response
 }
 var file_proto_main_proto_depIdxs = []int32{
-	0,  // 0: ConnectResponse.player:type_name -> Player
-	0,  // 1: Join.player:type_name -> Player
-	6,  // 2: Request.attack:type_name -> Attack
-	7,  // 3: Response.question:type_name -> Question
-	3,  // 4: Response.start:type_name -> Start
-	4,  // 5: Response.finish:type_name -> Finish
-	5,  // 6: Response.join:type_name -> Join
-	8,  // 7: Response.damage:type_name -> Damage
-	1,  // 8: Game.Connect:input_type -> ConnectRequest
-	9,  // 9: Game.Stream:input_type -> Request
-	2,  // 10: Game.Connect:output_type -> ConnectResponse
-	10, // 11: Game.Stream:output_type -> Response
-	10, // [10:12] is the sub-list for method output_type
-	8,  // [8:10] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	5,  // 0: ConnectRequest.client:type_name -> ClientInfo
+	4,  // 1: ConnectResponse.player:type_name -> Player
+	6,  // 2: ConnectResponse.settings:type_name -> Settings
+	9,  // 3: CreateRoomResponse.room:type_name -> Room
+	9,  // 4: ListRoomsResponse.room:type_name -> Room
+	5,  // 5: JoinRoomRequest.client:type_name -> ClientInfo
+	5,  // 6: SpectateRequest.client:type_name -> ClientInfo
+	4,  // 7: Start.player:type_name -> Player
+	17, // 8: Finish.result:type_name -> PlayerResult
+	4,  // 9: Join.player:type_name -> Player
+	1,  // 10: Attack.mode:type_name -> TargetMode
+	0,  // 11: Error.code:type_name -> ErrorCode
+	1,  // 12: Aim.mode:type_name -> TargetMode
+	2,  // 13: Question.effect:type_name -> Effect
+	3,  // 14: State.phase:type_name -> Phase
+	3,  // 15: Snapshot.phase:type_name -> Phase
+	4,  // 16: Snapshot.player:type_name -> Player
+	24, // 17: Snapshot.question:type_name -> Question
+	24, // 18: Snapshot.word:type_name -> Question
+	28, // 19: Snapshot.clock:type_name -> Clock
+	20, // 20: Request.attack:type_name -> Attack
+	36, // 21: Request.rematch:type_name -> Rematch
+	31, // 22: Request.progress:type_name -> Progress
+	22, // 23: Request.aim:type_name -> Aim
+	24, // 24: Response.question:type_name -> Question
+	16, // 25: Response.start:type_name -> Start
+	18, // 26: Response.finish:type_name -> Finish
+	19, // 27: Response.join:type_name -> Join
+	25, // 28: Response.damage:type_name -> Damage
+	37, // 29: Response.rematch_vote:type_name -> RematchVote
+	30, // 30: Response.state:type_name -> State
+	32, // 31: Response.progress:type_name -> PlayerProgress
+	33, // 32: Response.attack_result:type_name -> AttackResult
+	34, // 33: Response.eliminated:type_name -> Eliminated
+	35, // 34: Response.leave:type_name -> Leave
+	38, // 35: Response.snapshot:type_name -> Snapshot
+	26, // 36: Response.heal:type_name -> Heal
+	27, // 37: Response.shield:type_name -> Shield
+	29, // 38: Response.combo:type_name -> Combo
+	28, // 39: Response.clock:type_name -> Clock
+	23, // 40: Response.target_changed:type_name -> TargetChanged
+	21, // 41: Response.error:type_name -> Error
+	7,  // 42: Game.Connect:input_type -> ConnectRequest
+	39, // 43: Game.Stream:input_type -> Request
+	10, // 44: Game.CreateRoom:input_type -> CreateRoomRequest
+	12, // 45: Game.ListRooms:input_type -> ListRoomsRequest
+	14, // 46: Game.JoinRoom:input_type -> JoinRoomRequest
+	15, // 47: Game.Spectate:input_type -> SpectateRequest
+	8,  // 48: Game.Connect:output_type -> ConnectResponse
+	40, // 49: Game.Stream:output_type -> Response
+	11, // 50: Game.CreateRoom:output_type -> CreateRoomResponse
+	13, // 51: Game.ListRooms:output_type -> ListRoomsResponse
+	8,  // 52: Game.JoinRoom:output_type -> ConnectResponse
+	8,  // 53: Game.Spectate:output_type -> ConnectResponse
+	48, // [48:54] is the sub-list for method output_type
+	42, // [42:48] is the sub-list for method input_type
+	42, // [42:42] is the sub-list for extension type_name
+	42, // [42:42] is the sub-list for extension extendee
+	0,  // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_proto_main_proto_init() }
@@ -782,8 +3303,44 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectRequest); i {
+		file_proto_main_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClientInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Settings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -794,8 +3351,8 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectResponse); i {
+		file_proto_main_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Room); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -806,7 +3363,79 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRoomResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListRoomsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*JoinRoomRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SpectateRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Start); i {
 			case 0:
 				return &v.state
@@ -818,7 +3447,19 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Finish); i {
 			case 0:
 				return &v.state
@@ -830,7 +3471,7 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Join); i {
 			case 0:
 				return &v.state
@@ -842,7 +3483,7 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Attack); i {
 			case 0:
 				return &v.state
@@ -854,7 +3495,43 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Error); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Aim); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TargetChanged); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Question); i {
 			case 0:
 				return &v.state
@@ -866,7 +3543,7 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Damage); i {
 			case 0:
 				return &v.state
@@ -878,7 +3555,163 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Heal); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Shield); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clock); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Combo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*State); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Progress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PlayerProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttackResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Eliminated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Leave); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rematch); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RematchVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Snapshot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_main_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Request); i {
 			case 0:
 				return &v.state
@@ -890,7 +3723,7 @@ func file_proto_main_proto_init() {
 				return nil
 			}
 		}
-		file_proto_main_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+		file_proto_main_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
@@ -903,28 +3736,45 @@ func file_proto_main_proto_init() {
 			}
 		}
 	}
-	file_proto_main_proto_msgTypes[9].OneofWrappers = []interface{}{
+	file_proto_main_proto_msgTypes[35].OneofWrappers = []interface{}{
 		(*Request_Attack)(nil),
+		(*Request_Rematch)(nil),
+		(*Request_Progress)(nil),
+		(*Request_Aim)(nil),
 	}
-	file_proto_main_proto_msgTypes[10].OneofWrappers = []interface{}{
+	file_proto_main_proto_msgTypes[36].OneofWrappers = []interface{}{
 		(*Response_Question)(nil),
 		(*Response_Start)(nil),
 		(*Response_Finish)(nil),
 		(*Response_Join)(nil),
 		(*Response_Damage)(nil),
+		(*Response_RematchVote)(nil),
+		(*Response_State)(nil),
+		(*Response_Progress)(nil),
+		(*Response_AttackResult)(nil),
+		(*Response_Eliminated)(nil),
+		(*Response_Leave)(nil),
+		(*Response_Snapshot)(nil),
+		(*Response_Heal)(nil),
+		(*Response_Shield)(nil),
+		(*Response_Combo)(nil),
+		(*Response_Clock)(nil),
+		(*Response_TargetChanged)(nil),
+		(*Response_Error)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_main_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   11,
+			NumEnums:      4,
+			NumMessages:   37,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_main_proto_goTypes,
 		DependencyIndexes: file_proto_main_proto_depIdxs,
+		EnumInfos:         file_proto_main_proto_enumTypes,
 		MessageInfos:      file_proto_main_proto_msgTypes,
 	}.Build()
 	File_proto_main_proto = out.File
@@ -20,6 +20,10 @@ const _ = grpc.SupportPackageIsVersion7
 type GameClient interface {
 	Connect(ctx context.Context, in *ConnectRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
 	Stream(ctx context.Context, opts ...grpc.CallOption) (Game_StreamClient, error)
+	CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error)
+	ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error)
+	JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
+	Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*ConnectResponse, error)
 }
 
 type gameClient struct {
@@ -70,12 +74,52 @@ func (x *gameStreamClient) Recv() (*Response, error) {
 	return m, nil
 }
 
+func (c *gameClient) CreateRoom(ctx context.Context, in *CreateRoomRequest, opts ...grpc.CallOption) (*CreateRoomResponse, error) {
+	out := new(CreateRoomResponse)
+	err := c.cc.Invoke(ctx, "/Game/CreateRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) ListRooms(ctx context.Context, in *ListRoomsRequest, opts ...grpc.CallOption) (*ListRoomsResponse, error) {
+	out := new(ListRoomsResponse)
+	err := c.cc.Invoke(ctx, "/Game/ListRooms", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) JoinRoom(ctx context.Context, in *JoinRoomRequest, opts ...grpc.CallOption) (*ConnectResponse, error) {
+	out := new(ConnectResponse)
+	err := c.cc.Invoke(ctx, "/Game/JoinRoom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gameClient) Spectate(ctx context.Context, in *SpectateRequest, opts ...grpc.CallOption) (*ConnectResponse, error) {
+	out := new(ConnectResponse)
+	err := c.cc.Invoke(ctx, "/Game/Spectate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GameServer is the server API for Game service.
 // All implementations must embed UnimplementedGameServer
 // for forward compatibility
 type GameServer interface {
 	Connect(context.Context, *ConnectRequest) (*ConnectResponse, error)
 	Stream(Game_StreamServer) error
+	CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error)
+	ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error)
+	JoinRoom(context.Context, *JoinRoomRequest) (*ConnectResponse, error)
+	Spectate(context.Context, *SpectateRequest) (*ConnectResponse, error)
 	mustEmbedUnimplementedGameServer()
 }
 
@@ -89,6 +133,18 @@ func (UnimplementedGameServer) Connect(context.Context, *ConnectRequest) (*Conne
 func (UnimplementedGameServer) Stream(Game_StreamServer) error {
 	return status.Errorf(codes.Unimplemented, "method Stream not implemented")
 }
+func (UnimplementedGameServer) CreateRoom(context.Context, *CreateRoomRequest) (*CreateRoomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoom not implemented")
+}
+func (UnimplementedGameServer) ListRooms(context.Context, *ListRoomsRequest) (*ListRoomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRooms not implemented")
+}
+func (UnimplementedGameServer) JoinRoom(context.Context, *JoinRoomRequest) (*ConnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinRoom not implemented")
+}
+func (UnimplementedGameServer) Spectate(context.Context, *SpectateRequest) (*ConnectResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Spectate not implemented")
+}
 func (UnimplementedGameServer) mustEmbedUnimplementedGameServer() {}
 
 // UnsafeGameServer may be embedded to opt out of forward compatibility for this service.
@@ -146,6 +202,78 @@ func (x *gameStreamServer) Recv() (*Request, error) {
 	return m, nil
 }
 
+func _Game_CreateRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).CreateRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Game/CreateRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).CreateRoom(ctx, req.(*CreateRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_ListRooms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).ListRooms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Game/ListRooms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).ListRooms(ctx, req.(*ListRoomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_JoinRoom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinRoomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).JoinRoom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Game/JoinRoom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).JoinRoom(ctx, req.(*JoinRoomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Game_Spectate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpectateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GameServer).Spectate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Game/Spectate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GameServer).Spectate(ctx, req.(*SpectateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Game_ServiceDesc is the grpc.ServiceDesc for Game service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -157,6 +285,22 @@ var Game_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Connect",
 			Handler:    _Game_Connect_Handler,
 		},
+		{
+			MethodName: "CreateRoom",
+			Handler:    _Game_CreateRoom_Handler,
+		},
+		{
+			MethodName: "ListRooms",
+			Handler:    _Game_ListRooms_Handler,
+		},
+		{
+			MethodName: "JoinRoom",
+			Handler:    _Game_JoinRoom_Handler,
+		},
+		{
+			MethodName: "Spectate",
+			Handler:    _Game_Spectate_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
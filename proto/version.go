@@ -0,0 +1,17 @@
+package proto
+
+// 通信プロトコルのバージョン。互換性のない変更をしたら上げる
+const ProtocolVersion = 1
+
+// サーバとクライアントのバージョン。ビルド時に-ldflagsで指定する
+var Version = "dev"
+
+// クライアントが対応している機能の名前
+const (
+	// 回復、シールド、ダメージ2倍のお題
+	PowerUpCapability = "powerup"
+	// 連続正解によるダメージ倍率
+	ComboCapability = "combo"
+	// 制限時間とサドンデス
+	ClockCapability = "clock"
+)
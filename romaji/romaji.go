@@ -0,0 +1,243 @@
+package romaji
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// ひらがな1文字または拗音2文字に対応するローマ字の綴り
+// 先頭の綴りを代表的な表記として扱う
+var romajiTable = map[string][]string{
+	"あ": {"a"}, "い": {"i", "yi"}, "う": {"u", "wu", "whu"}, "え": {"e"}, "お": {"o"},
+	"か": {"ka", "ca"}, "き": {"ki"}, "く": {"ku", "cu", "qu"}, "け": {"ke"}, "こ": {"ko", "co"},
+	"さ": {"sa"}, "し": {"si", "shi", "ci"}, "す": {"su"}, "せ": {"se", "ce"}, "そ": {"so"},
+	"た": {"ta"}, "ち": {"ti", "chi"}, "つ": {"tu", "tsu"}, "て": {"te"}, "と": {"to"},
+	"な": {"na"}, "に": {"ni"}, "ぬ": {"nu"}, "ね": {"ne"}, "の": {"no"},
+	"は": {"ha"}, "ひ": {"hi"}, "ふ": {"hu", "fu"}, "へ": {"he"}, "ほ": {"ho"},
+	"ま": {"ma"}, "み": {"mi"}, "む": {"mu"}, "め": {"me"}, "も": {"mo"},
+	"や": {"ya"}, "ゆ": {"yu"}, "よ": {"yo"},
+	"ら": {"ra"}, "り": {"ri"}, "る": {"ru"}, "れ": {"re"}, "ろ": {"ro"},
+	"わ": {"wa"}, "ゐ": {"wyi"}, "ゑ": {"wye"}, "を": {"wo"},
+	"が": {"ga"}, "ぎ": {"gi"}, "ぐ": {"gu"}, "げ": {"ge"}, "ご": {"go"},
+	"ざ": {"za"}, "じ": {"zi", "ji"}, "ず": {"zu"}, "ぜ": {"ze"}, "ぞ": {"zo"},
+	"だ": {"da"}, "ぢ": {"di"}, "づ": {"du"}, "で": {"de"}, "ど": {"do"},
+	"ば": {"ba"}, "び": {"bi"}, "ぶ": {"bu"}, "べ": {"be"}, "ぼ": {"bo"},
+	"ぱ": {"pa"}, "ぴ": {"pi"}, "ぷ": {"pu"}, "ぺ": {"pe"}, "ぽ": {"po"},
+	"ゔ": {"vu"},
+	"ぁ": {"la", "xa"}, "ぃ": {"li", "xi"}, "ぅ": {"lu", "xu"}, "ぇ": {"le", "xe"}, "ぉ": {"lo", "xo"},
+	"ゃ": {"lya", "xya"}, "ゅ": {"lyu", "xyu"}, "ょ": {"lyo", "xyo"}, "ゎ": {"lwa", "xwa"},
+	"っ": {"ltu", "xtu", "ltsu"},
+	"ー": {"-"}, "、": {","}, "。": {"."}, "・": {"/"},
+
+	"きゃ": {"kya"}, "きぃ": {"kyi"}, "きゅ": {"kyu"}, "きぇ": {"kye"}, "きょ": {"kyo"},
+	"しゃ": {"sya", "sha"}, "しぃ": {"syi"}, "しゅ": {"syu", "shu"}, "しぇ": {"sye", "she"}, "しょ": {"syo", "sho"},
+	"ちゃ": {"tya", "cha", "cya"}, "ちぃ": {"tyi", "cyi"}, "ちゅ": {"tyu", "chu", "cyu"}, "ちぇ": {"tye", "che", "cye"}, "ちょ": {"tyo", "cho", "cyo"},
+	"にゃ": {"nya"}, "にぃ": {"nyi"}, "にゅ": {"nyu"}, "にぇ": {"nye"}, "にょ": {"nyo"},
+	"ひゃ": {"hya"}, "ひぃ": {"hyi"}, "ひゅ": {"hyu"}, "ひぇ": {"hye"}, "ひょ": {"hyo"},
+	"みゃ": {"mya"}, "みぃ": {"myi"}, "みゅ": {"myu"}, "みぇ": {"mye"}, "みょ": {"myo"},
+	"りゃ": {"rya"}, "りぃ": {"ryi"}, "りゅ": {"ryu"}, "りぇ": {"rye"}, "りょ": {"ryo"},
+	"ぎゃ": {"gya"}, "ぎぃ": {"gyi"}, "ぎゅ": {"gyu"}, "ぎぇ": {"gye"}, "ぎょ": {"gyo"},
+	"じゃ": {"zya", "ja", "jya"}, "じぃ": {"zyi", "jyi"}, "じゅ": {"zyu", "ju", "jyu"}, "じぇ": {"zye", "je", "jye"}, "じょ": {"zyo", "jo", "jyo"},
+	"ぢゃ": {"dya"}, "ぢぃ": {"dyi"}, "ぢゅ": {"dyu"}, "ぢぇ": {"dye"}, "ぢょ": {"dyo"},
+	"びゃ": {"bya"}, "びぃ": {"byi"}, "びゅ": {"byu"}, "びぇ": {"bye"}, "びょ": {"byo"},
+	"ぴゃ": {"pya"}, "ぴぃ": {"pyi"}, "ぴゅ": {"pyu"}, "ぴぇ": {"pye"}, "ぴょ": {"pyo"},
+	"ふぁ": {"fa"}, "ふぃ": {"fi"}, "ふぇ": {"fe"}, "ふぉ": {"fo"}, "ふゅ": {"fyu"},
+	"てぃ": {"thi"}, "てゅ": {"thu"}, "でぃ": {"dhi"}, "でゅ": {"dhu"},
+	"とぅ": {"twu"}, "どぅ": {"dwu"},
+	"うぃ": {"wi"}, "うぇ": {"we"}, "うぉ": {"who"},
+	"ゔぁ": {"va"}, "ゔぃ": {"vi"}, "ゔぇ": {"ve"}, "ゔぉ": {"vo"},
+	"つぁ": {"tsa"}, "つぃ": {"tsi"}, "つぇ": {"tse"}, "つぉ": {"tso"},
+}
+
+// 入力されたローマ字が読みと完全に一致するか判定する
+// し(si/shi)、つ(tu/tsu)、ん(n/nn) などの複数の綴りを受け付ける
+func Match(reading string, input string) bool {
+	return newRomajiMatcher(reading, input, false).match(0, 0)
+}
+
+// 入力されたローマ字で読みの何文字目まで入力できたかを返す
+// 読みの途中までと一致しない場合はfalseを返す
+func Progress(reading string, input string) (int, int, bool) {
+	matcher := newRomajiMatcher(reading, input, true)
+	ok := matcher.match(0, 0)
+	return matcher.reached, len(matcher.kana), ok
+}
+
+// 読みを代表的な綴りのローマ字に変換する
+func Romanize(reading string) string {
+	m := newRomajiMatcher(reading, "", false)
+	var b strings.Builder
+	for i := 0; i < len(m.kana); {
+		for _, unit := range m.units(i) {
+			if len(unit.spellings) == 0 {
+				continue
+			}
+			b.WriteString(unit.spellings[0])
+			i += unit.length
+			break
+		}
+	}
+	return b.String()
+}
+
+type romajiMatcher struct {
+	kana   []string
+	input  string
+	prefix bool
+	// 入力を全て消費した時点でのかなの位置
+	reached int
+	// 探索済みの(kana, input)の位置
+	visited map[[2]int]bool
+}
+
+func newRomajiMatcher(reading string, input string, prefix bool) *romajiMatcher {
+	kana := []string{}
+	for _, r := range toHiragana(reading) {
+		kana = append(kana, string(r))
+	}
+	return &romajiMatcher{
+		kana:    kana,
+		input:   strings.ToLower(input),
+		prefix:  prefix,
+		visited: make(map[[2]int]bool),
+	}
+}
+
+// kana[i:] と input[j:] が一致するか
+func (m *romajiMatcher) match(i int, j int) bool {
+	if j == len(m.input) && (m.prefix || i == len(m.kana)) {
+		m.reached = i
+		return true
+	}
+	if i == len(m.kana) {
+		return false
+	}
+
+	key := [2]int{i, j}
+	if m.visited[key] {
+		return false
+	}
+	m.visited[key] = true
+
+	rest := m.input[j:]
+	for _, unit := range m.units(i) {
+		for _, spelling := range unit.spellings {
+			if strings.HasPrefix(rest, spelling) {
+				if m.match(i+unit.length, j+len(spelling)) {
+					return true
+				}
+			} else if m.prefix && strings.HasPrefix(spelling, rest) {
+				m.reached = i
+				return true
+			}
+		}
+	}
+	return false
+}
+
+type romajiUnit struct {
+	// 消費するかなの文字数
+	length    int
+	spellings []string
+}
+
+// kana[i:] の先頭に当てはまる綴りの候補
+func (m *romajiMatcher) units(i int) []romajiUnit {
+	units := []romajiUnit{}
+	kana := m.kana[i]
+
+	switch kana {
+	case "ん":
+		spellings := []string{"nn", "xn", "n'"}
+		// 次が母音・な行・や行でなければ n 1文字でよい
+		if m.singleNAllowed(i + 1) {
+			spellings = append(spellings, "n")
+		}
+		return append(units, romajiUnit{length: 1, spellings: spellings})
+	case "っ":
+		if i+1 >= len(m.kana) {
+			break
+		}
+		// 次の子音を重ねる
+		for _, next := range m.units(i + 1) {
+			doubled := []string{}
+			for _, spelling := range next.spellings {
+				if isDoubleConsonant(spelling[0]) {
+					doubled = append(doubled, spelling[:1]+spelling)
+				}
+				// っち は tchi とも綴る
+				if strings.HasPrefix(spelling, "ch") {
+					doubled = append(doubled, "t"+spelling)
+				}
+			}
+			units = append(units, romajiUnit{length: next.length + 1, spellings: doubled})
+		}
+	}
+
+	if i+1 < len(m.kana) {
+		if spellings, ok := romajiTable[kana+m.kana[i+1]]; ok {
+			units = append(units, romajiUnit{length: 2, spellings: spellings})
+		}
+	}
+	if spellings, ok := romajiTable[kana]; ok {
+		units = append(units, romajiUnit{length: 1, spellings: spellings})
+	} else {
+		// 英数字などはそのまま入力する
+		units = append(units, romajiUnit{length: 1, spellings: []string{strings.ToLower(kana)}})
+	}
+	return units
+}
+
+func (m *romajiMatcher) singleNAllowed(next int) bool {
+	if next >= len(m.kana) {
+		return false
+	}
+	for _, unit := range m.units(next) {
+		for _, spelling := range unit.spellings {
+			switch spelling[0] {
+			case 'a', 'i', 'u', 'e', 'o', 'n', 'y':
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isDoubleConsonant(c byte) bool {
+	switch c {
+	case 'a', 'i', 'u', 'e', 'o', 'n', 'l', 'x', '-', ',', '.', '/':
+		return false
+	}
+	return 'a' <= c && c <= 'z'
+}
+
+// 読みがローマ字で入力できる文字だけで書かれているか判定する
+func IsTypeable(reading string) bool {
+	for _, r := range toHiragana(reading) {
+		if r < utf8.RuneSelf {
+			if r <= ' ' || r == 0x7f {
+				return false
+			}
+			continue
+		}
+		if _, ok := romajiTable[string(r)]; !ok && r != 'ん' {
+			return false
+		}
+	}
+	return true
+}
+
+// カタカナをひらがなに変換する
+func toHiragana(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for len(s) > 0 {
+		r, size := utf8.DecodeRuneInString(s)
+		s = s[size:]
+		if 'ァ' <= r && r <= 'ヶ' {
+			r -= 'ァ' - 'ぁ'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
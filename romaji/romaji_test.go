@@ -0,0 +1,101 @@
+package romaji
+
+import "testing"
+
+func TestMatchRomaji(t *testing.T) {
+	tests := []struct {
+		reading string
+		input   string
+		want    bool
+	}{
+		// し、つ、ち、じ などの綴りの揺れ
+		{"すし", "sushi", true},
+		{"すし", "susi", true},
+		{"すし", "sushu", false},
+		{"つくえ", "tsukue", true},
+		{"つくえ", "tukue", true},
+		{"ちず", "chizu", true},
+		{"ちず", "tizu", true},
+		{"しゃしん", "shashinn", true},
+		{"しゃしん", "syasinn", true},
+		// 促音は次の子音を重ねる。っち は tchi とも綴る
+		{"かっこ", "kakko", true},
+		{"かっこ", "kako", false},
+		{"まっち", "macchi", true},
+		{"まっち", "matchi", true},
+		{"まっち", "matti", true},
+		{"まっち", "machi", false},
+		// ん は子音の前なら n 1文字でよい
+		{"かんじ", "kanji", true},
+		{"かんじ", "kannji", true},
+		// 母音の前では nn が必要
+		{"きんえん", "kinnenn", true},
+		{"きんえん", "kinenn", false},
+		// 単語の最後の ん は nn
+		{"ほん", "honn", true},
+		{"ほん", "hon", false},
+		{"ほん", "hoxn", true},
+		// カタカナはひらがなとして扱い、大文字も受け付ける
+		{"カタカナ", "katakana", true},
+		{"すし", "SUSHI", true},
+		{"すし", "", false},
+	}
+	for _, tt := range tests {
+		if got := Match(tt.reading, tt.input); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.reading, tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestRomajiProgress(t *testing.T) {
+	tests := []struct {
+		reading string
+		input   string
+		reached int
+		total   int
+		ok      bool
+	}{
+		{"すし", "", 0, 2, true},
+		{"すし", "s", 0, 2, true},
+		{"すし", "su", 1, 2, true},
+		// 入力途中の綴りはそのかなの手前まで
+		{"すし", "sus", 1, 2, true},
+		{"すし", "sush", 1, 2, true},
+		{"すし", "sushi", 2, 2, true},
+		{"すし", "sa", 0, 2, false},
+		{"ほん", "hon", 1, 2, true},
+		{"ほん", "honn", 2, 2, true},
+		{"まっち", "mat", 1, 3, true},
+		{"まっち", "matchi", 3, 3, true},
+	}
+	for _, tt := range tests {
+		reached, total, ok := Progress(tt.reading, tt.input)
+		if reached != tt.reached || total != tt.total || ok != tt.ok {
+			t.Errorf("Progress(%q, %q) = (%v, %v, %v), want (%v, %v, %v)",
+				tt.reading, tt.input, reached, total, ok, tt.reached, tt.total, tt.ok)
+		}
+	}
+}
+
+func TestRomanize(t *testing.T) {
+	tests := []struct {
+		reading string
+		want    string
+	}{
+		// 表の先頭の綴りを使う
+		{"すし", "susi"},
+		{"ほん", "honn"},
+		{"かんじ", "kannzi"},
+		{"かっこ", "kakko"},
+	}
+	for _, tt := range tests {
+		got := Romanize(tt.reading)
+		if got != tt.want {
+			t.Errorf("Romanize(%q) = %q, want %q", tt.reading, got, tt.want)
+		}
+		// 代表的な綴りは必ず受け付ける
+		if !Match(tt.reading, got) {
+			t.Errorf("Match(%q, Romanize) = false for %q", tt.reading, got)
+		}
+	}
+}
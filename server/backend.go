@@ -10,7 +10,15 @@ import (
 )
 
 const MaxScore = 10
-const InitialHealth = 15
+
+// プレイヤーの初期体力
+var InitialHealth = 15
+
+// 全員揃ってからStartEventを送るまでの時間
+var startDelay = 1 * time.Second
+
+// StartEventを送ってから最初の問題を出すまでの時間
+var countdownDuration = 5 * time.Second
 
 func init() {
 	rand.Seed(time.Now().UnixNano())
@@ -20,15 +28,112 @@ type Action interface {
 	Perform(game *Game)
 }
 
+type JoinAction struct {
+	ID   uuid.UUID
+	Name string
+	// 希望するチーム。0なら自動で割り当てる
+	Team   int
+	result chan joinResult
+}
+
+type joinResult struct {
+	players []Player
+	team    int
+	health  int
+	// ゲーム開始後に参加した
+	late bool
+	err  error
+}
+
+type LeaveAction struct {
+	ID uuid.UUID
+}
+
 type AttackAction struct {
+	ID   uuid.UUID
+	Text string
+	// 攻撃対象のID。ModeがDirectTargetのときのみ使う
+	Target string
+	Mode   TargetMode
+}
+
+// 攻撃対象を変えたときに発行されるAction
+type AimAction struct {
 	ID     uuid.UUID
-	Text   string
 	Target string
+	Mode   TargetMode
+}
+
+type ProgressAction struct {
+	ID   uuid.UUID
+	Text string
+}
+
+type RematchAction struct {
+	ID uuid.UUID
+}
+
+// 再接続したプレイヤーまたは観戦者へ現在の状態を送る
+type SnapshotAction struct {
+	ID        uuid.UUID
+	Spectator bool
+}
+
+// 回復のお題を入力したときに発行されるAction
+type HealAction struct {
+	ID     uuid.UUID
+	Amount int
+}
+
+// シールドのお題を入力したときに発行されるAction
+type ShieldAction struct {
+	ID     uuid.UUID
+	Amount int
+}
+
+// カウントダウン開始時に自動で発行されるAction
+type countdownAction struct {
+	round int
+}
+
+// カウントダウン終了時に自動で発行されるAction
+type playAction struct {
+	round int
 }
 
 type PlayerInfo struct {
 	Name   string
 	Health int
+	// 入力に成功した回数
+	Hit int
+	// 入力ミスの回数
+	Miss int
+	// 正解した単語の打鍵数
+	Keystrokes int
+	// 与えたダメージの合計
+	DamageDealt int
+	// 受けたダメージの合計
+	DamageTaken int
+	// この時刻まで入力を受け付けない
+	LockedUntil time.Time
+	// サーバが追加したボット
+	Bot bool
+	// 所属するチーム。個人戦では0
+	Team int
+	// 受けるダメージを肩代わりする量
+	Shield int
+	// 現在のお題の効果
+	Effect Effect
+	// 連続で正解した回数
+	Combo int
+	// 現在の攻撃対象。最後に狙った相手
+	Target uuid.UUID
+	// 最後に自分にダメージを与えた相手
+	LastAttacker uuid.UUID
+	// コンボを伸ばすたびに増える。古い時間切れを無視するために使う
+	comboTurn int
+	// ボットが入力を予約するたびに増える。古い予約を無視するために使う
+	botTurn int
 }
 
 type Game struct {
@@ -37,20 +142,45 @@ type Game struct {
 	PlayerID      []uuid.UUID
 	ActionChannel chan Action
 	EventChannel  chan Event
-	HasStarted    bool
+	State         State
+	RematchVote   map[uuid.UUID]bool
 	PlayerCount   int
-	Mu            sync.RWMutex
+	Capacity      int
+	// 出題に使うデータセット名
+	Dataset string
+	// チーム数。0なら個人戦
+	Teams int
+	// 対戦を開始した時刻
+	startedAt time.Time
+	// 脱落した順のプレイヤーの成績
+	eliminated []PlayerResult
+	// ボットで埋めるまでの待ちを始めるたびに増える
+	wait int
+	// 再戦のたびに増える。古いタイマーのActionを無視するために使う
+	round int
+	// 制限時間またはサドンデスで次に倍率が上がる時刻。制限がなければゼロ値
+	deadline time.Time
+	// サドンデスでダメージが2倍になった回数
+	suddenDeath int
+	quit        chan struct{}
+	Mu          sync.RWMutex
 }
 
-func NewGame() *Game {
+func NewGame(capacity int, dataset string, teams int) *Game {
 	game := &Game{
 		Problem:       make(map[uuid.UUID]IIterator),
 		PlayerInfo:    make(map[uuid.UUID]*PlayerInfo),
 		PlayerID:      []uuid.UUID{},
 		ActionChannel: make(chan Action, 1),
 		EventChannel:  make(chan Event, 1),
-		HasStarted:    false,
+		State:         Waiting,
+		RematchVote:   make(map[uuid.UUID]bool),
 		PlayerCount:   0,
+		Capacity:      capacity,
+		Dataset:       dataset,
+		Teams:         teams,
+		round:         0,
+		quit:          make(chan struct{}),
 		Mu:            sync.RWMutex{},
 	}
 
@@ -58,100 +188,584 @@ func NewGame() *Game {
 }
 
 func (g *Game) Start() {
-	go g.watchPlayerCount()
 	go g.watchAction()
-	go g.watchWinner()
 }
 
-func (g *Game) watchPlayerCount() {
-	for g.PlayerCount < PlayerCount {
-		continue
-	}
-
-	time.Sleep(1 * time.Second)
-	g.EventChannel <- StartEvent{}
-	time.Sleep(5 * time.Second)
-	g.HasStarted = true
-	for _, id := range g.PlayerID {
-		g.Question(id)
-	}
+func (g *Game) Stop() {
+	close(g.quit)
 }
 
+// Actionを1つずつ処理する。ゲームの状態を変更するのはこのgoroutineだけ
 func (g *Game) watchAction() {
 	for {
-		action := <-g.ActionChannel
-		if !g.HasStarted {
-			continue
+		select {
+		case action := <-g.ActionChannel:
+			g.Mu.Lock()
+			action.Perform(g)
+			g.Mu.Unlock()
+		case <-g.quit:
+			return
 		}
-		g.Mu.Lock()
-		action.Perform(g)
-		g.Mu.Unlock()
 	}
 }
 
-func (g *Game) watchWinner() {
-	for {
-		if !g.HasStarted {
-			continue
+// 一定時間後にActionを発行する
+func (g *Game) after(d time.Duration, action Action) {
+	time.AfterFunc(d, func() {
+		select {
+		case g.ActionChannel <- action:
+		case <-g.quit:
 		}
+	})
+}
 
-		g.Mu.RLock()
-		// 体力が1以上のプレイヤーが1人のとき終了
-		var count = 0
-		for _, player := range g.PlayerInfo {
-			if player.Health >= 1 {
-				count += 1
-			}
+// 現在の状態を返す
+func (g *Game) CurrentState() State {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.State
+}
+
+// プレイヤーをゲームに参加させ、参加済みのプレイヤー一覧と割り当てたチームを返す
+func (g *Game) Join(id uuid.UUID, name string, team int) (joinResult, error) {
+	action := JoinAction{
+		ID:     id,
+		Name:   name,
+		Team:   team,
+		result: make(chan joinResult, 1),
+	}
+	select {
+	case g.ActionChannel <- action:
+	case <-g.quit:
+		return joinResult{}, ErrRoomClosed
+	}
+	// Actionを処理する前に部屋が閉じられた場合は結果が返らない
+	select {
+	case result := <-action.result:
+		return result, result.err
+	case <-g.quit:
+		return joinResult{}, ErrRoomClosed
+	}
+}
+
+// 切断したプレイヤーをゲームから取り除く
+func (g *Game) Leave(id uuid.UUID) {
+	select {
+	case g.ActionChannel <- LeaveAction{ID: id}:
+	case <-g.quit:
+	}
+}
+
+// 再接続したプレイヤーまたは観戦者へ現在の状態を送る
+func (g *Game) Snapshot(id uuid.UUID, spectator bool) {
+	select {
+	case g.ActionChannel <- SnapshotAction{ID: id, Spectator: spectator}:
+	case <-g.quit:
+	}
+}
+
+// 参加しているプレイヤーの一覧を返す
+func (g *Game) Players() []Player {
+	g.Mu.RLock()
+	defer g.Mu.RUnlock()
+	return g.players()
+}
+
+func (g *Game) players() []Player {
+	players := []Player{}
+	for _, id := range g.PlayerID {
+		players = append(players, Player{
+			ID:     id.String(),
+			Name:   g.PlayerInfo[id].Name,
+			Health: g.PlayerInfo[id].Health,
+			Bot:    g.PlayerInfo[id].Bot,
+			Team:   g.PlayerInfo[id].Team,
+			Shield: g.PlayerInfo[id].Shield,
+			Combo:  g.PlayerInfo[id].Combo,
+			Target: g.target(id),
+		})
+	}
+	return players
+}
+
+// ボット以外のプレイヤー数
+func (g *Game) humans() int {
+	count := 0
+	for _, player := range g.PlayerInfo {
+		if !player.Bot {
+			count++
 		}
+	}
+	return count
+}
 
-		if count == 1 {
-			for k, player := range g.PlayerInfo {
-				if player.Health >= 1 {
-					g.EventChannel <- FinishEvent{
-						Winner: g.PlayerInfo[k].Name,
-					}
-					return
-				}
-			}
+// プレイヤーを追加して他のプレイヤーへ通知する
+func (g *Game) addPlayer(id uuid.UUID, name string, bot bool, team int, health int) {
+	g.PlayerID = append(g.PlayerID, id)
+	g.Problem[id] = NewDatasetIterator(g.Dataset)
+	g.PlayerInfo[id] = &PlayerInfo{
+		Health: health,
+		Name:   name,
+		Bot:    bot,
+		Team:   team,
+	}
+	g.PlayerCount++
+
+	g.EventChannel <- JoinEvent{
+		ID:     id.String(),
+		Name:   name,
+		Health: health,
+		Bot:    bot,
+		Team:   team,
+	}
+}
+
+// カウントダウンを開始する
+func (g *Game) countdown() {
+	g.round++
+	g.setState(Countdown)
+	g.after(startDelay, countdownAction{round: g.round})
+}
+
+// 体力と問題をリセットして再戦の準備をする
+func (g *Game) reset() {
+	g.RematchVote = make(map[uuid.UUID]bool)
+	g.eliminated = nil
+	for _, id := range g.PlayerID {
+		g.PlayerInfo[id] = &PlayerInfo{
+			Name:   g.PlayerInfo[id].Name,
+			Health: InitialHealth,
+			Bot:    g.PlayerInfo[id].Bot,
+			Team:   g.PlayerInfo[id].Team,
 		}
+		g.Problem[id] = NewDatasetIterator(g.Dataset)
+	}
+}
+
+// 体力が1以上のプレイヤーが1人以下になったとき終了
+// チーム戦では生存者のいるチームが1つ以下になったとき終了
+func (g *Game) checkWinner() {
+	if g.State != Playing {
+		return
+	}
+
+	count, winner := g.survivors()
+	if count > 1 {
+		return
+	}
+	g.finish(winner)
+}
+
+// 対戦を終了して成績を通知する
+func (g *Game) finish(winner string) {
+	g.setState(Finished)
+	g.EventChannel <- FinishEvent{
+		Winner:  winner,
+		Results: g.results(),
+	}
+}
 
-		g.Mu.RUnlock()
+// 投票が揃っていれば再戦を開始する
+// ボットは投票しない
+func (g *Game) checkRematch() {
+	if g.State != Finished || g.humans() == 0 {
+		return
+	}
+	if len(g.RematchVote) < g.humans() {
+		return
+	}
+
+	log.Println("start rematch")
+	g.reset()
+	g.countdown()
+}
+
+// 操作を受け付けなかったことをプレイヤーへ通知する
+func (g *Game) reject(id uuid.UUID, err *GameError) {
+	g.EventChannel <- ErrorEvent{
+		ID:  id,
+		Err: err,
 	}
 }
 
-func (game *Game) DamagePlayer(target string) {
+// 与えたダメージを返す
+// 攻撃したプレイヤーのコンボに応じてダメージが増える。ペナルティではattackerはuuid.Nil
+func (game *Game) DamagePlayer(attacker uuid.UUID, target string, amount int) int {
 	id, _ := uuid.Parse(target)
-	game.PlayerInfo[id].Health--
+	player := game.PlayerInfo[id]
+	if player.Health <= 0 {
+		return 0
+	}
+	if info, ok := game.PlayerInfo[attacker]; ok {
+		amount *= info.comboMultiplier()
+		player.LastAttacker = attacker
+	}
+	// サドンデス中はダメージが増える
+	amount *= game.damageScale()
+	// シールドが残っていれば先に減らす
+	if player.Shield > 0 {
+		absorbed := amount
+		if absorbed > player.Shield {
+			absorbed = player.Shield
+		}
+		player.Shield -= absorbed
+		amount -= absorbed
+		game.EventChannel <- ShieldEvent{
+			ID:     target,
+			Shield: player.Shield,
+		}
+		if amount == 0 {
+			return 0
+		}
+	}
+	if amount > player.Health {
+		amount = player.Health
+	}
+	player.Health -= amount
+	player.DamageTaken += amount
 	game.EventChannel <- DamageEvent{
 		ID:     target,
-		Damage: game.PlayerInfo[id].Health,
+		Damage: player.Health,
+		Amount: amount,
+	}
+
+	if player.Health == 0 {
+		game.eliminate(id)
+		game.EventChannel <- EliminatedEvent{
+			ID: target,
+		}
+		game.setTarget(id, uuid.Nil)
+		game.clearTargets(id)
+	}
+	return amount
+}
+
+func (action JoinAction) Perform(game *Game) {
+	if game.State != Waiting && LateJoin != PlayLateJoin {
+		action.result <- joinResult{err: ErrGameStarted}
+		return
+	}
+	if game.PlayerCount >= game.Capacity {
+		action.result <- joinResult{err: ErrRoomFull}
+		return
+	}
+
+	team, err := game.assignTeam(action.Team)
+	if err != nil {
+		action.result <- joinResult{err: err}
+		return
 	}
+
+	// 対戦中に途中参加したプレイヤーは体力を減らしてすぐにお題を出す
+	health := InitialHealth
+	if game.State == Playing {
+		health = lateJoinHealth()
+	}
+
+	players := game.players()
+	action.result <- joinResult{players: players, team: team, health: health, late: game.State != Waiting}
+	game.addPlayer(action.ID, action.Name, false, team, health)
+
+	if game.State == Playing {
+		game.Question(action.ID)
+		return
+	}
+	if game.State != Waiting {
+		return
+	}
+	// 全員揃ったらカウントダウン開始
+	if game.PlayerCount >= game.Capacity {
+		game.countdown()
+		return
+	}
+	// 最初のプレイヤーが参加したらボットで埋めるまでの待ちを始める
+	if game.humans() == 1 {
+		game.waitForBots()
+	}
+}
+
+func (action LeaveAction) Perform(game *Game) {
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok {
+		return
+	}
+
+	// 対戦中に退出した場合は脱落として記録する
+	if game.State == Playing && player.Health > 0 {
+		game.eliminate(action.ID)
+	}
+
+	for i, playerID := range game.PlayerID {
+		if playerID == action.ID {
+			game.PlayerID = append(game.PlayerID[:i], game.PlayerID[i+1:]...)
+			break
+		}
+	}
+	delete(game.Problem, action.ID)
+	delete(game.PlayerInfo, action.ID)
+	delete(game.RematchVote, action.ID)
+	game.PlayerCount--
+	game.EventChannel <- LeaveEvent{
+		ID: action.ID.String(),
+	}
+	game.clearTargets(action.ID)
+
+	// 誰もいなくなったらボットで埋めるのをやめる
+	if game.State == Waiting && game.humans() == 0 {
+		game.wait++
+	}
+
+	game.checkWinner()
+	game.checkRematch()
+}
+
+func (action countdownAction) Perform(game *Game) {
+	if game.State != Countdown || action.round != game.round {
+		return
+	}
+
+	game.EventChannel <- StartEvent{
+		Players:   game.players(),
+		Countdown: countdownDuration,
+	}
+	game.after(countdownDuration, playAction{round: action.round})
+}
+
+func (action playAction) Perform(game *Game) {
+	if game.State != Countdown || action.round != game.round {
+		return
+	}
+
+	game.setState(Playing)
+	game.startedAt = time.Now()
+	game.startClock()
+	for _, id := range game.PlayerID {
+		game.Question(id)
+	}
+	game.checkWinner()
 }
 
 func (action AttackAction) Perform(game *Game) {
-	// Healthが0なら無効
+	// 対戦中以外は無効
 	id := action.ID
-	if game.PlayerInfo[id].Health <= 0 {
+	if game.State != Playing {
+		game.reject(id, ErrNotYourTurn)
+		return
+	}
+
+	// Healthが0なら無効
+	player, ok := game.PlayerInfo[id]
+	if !ok || player.Health <= 0 {
+		game.reject(id, ErrNotYourTurn)
 		return
 	}
 
-	// 不正解ならreturn
-	if action.Text != game.Problem[action.ID].Peek() {
+	// ペナルティで入力を受け付けない間は無効
+	if remaining := time.Until(player.LockedUntil); remaining > 0 {
+		game.EventChannel <- AttackResultEvent{
+			ID:      id,
+			Hit:     false,
+			Lockout: remaining,
+		}
 		return
 	}
 
+	// 不正解ならペナルティ
+	word := game.Problem[action.ID].Peek()
+	if !word.Match(action.Text) {
+		player.Miss++
+		result := AttackResultEvent{
+			ID:      id,
+			Hit:     false,
+			Penalty: PenaltyMode,
+		}
+		if PenaltyMode == LockoutPenalty {
+			result.Lockout = lockoutDuration
+		}
+		game.EventChannel <- result
+		game.breakCombo(id)
+		game.punish(id)
+		game.checkWinner()
+		return
+	}
+
+	// 攻撃対象を決める。自分、味方、体力が0のプレイヤーは攻撃できない
+	// 回復とシールドのお題は攻撃対象がなくてもよい
+	// 無効な入力で攻撃対象が変わらないよう、正解してから決める
+	targetID := uuid.Nil
+	if player.Effect.attack() {
+		var ok bool
+		targetID, ok = game.resolveTarget(id, action.Mode, action.Target)
+		if !ok {
+			game.reject(id, ErrInvalidTarget)
+			return
+		}
+		game.setTarget(id, targetID)
+	}
+
+	player.Hit++
+	player.Keystrokes += len(action.Text)
+	game.EventChannel <- AttackResultEvent{
+		ID:  id,
+		Hit: true,
+	}
 	game.Problem[action.ID].Next()
-	// ダメージ処理
-	game.DamagePlayer(action.Target)
-	targetID, _ := uuid.Parse(action.Target)
-	name := game.PlayerInfo[targetID].Name
-	log.Printf("%v's health is %v", name, game.PlayerInfo[action.ID].Health)
+	game.extendCombo(id)
+	// お題の効果を適用する
+	amount := player.Effect.Amount(word)
+	switch player.Effect {
+	case HealEffect:
+		HealAction{ID: id, Amount: amount}.Perform(game)
+	case ShieldEffect:
+		ShieldAction{ID: id, Amount: amount}.Perform(game)
+	default:
+		// ダメージ処理
+		player.DamageDealt += game.DamagePlayer(id, targetID.String(), amount)
+		name := game.PlayerInfo[targetID].Name
+		log.Printf("%v's health is %v", name, game.PlayerInfo[targetID].Health)
+	}
 	game.Question(action.ID)
+	game.checkWinner()
+}
+
+// 初期体力を上限に回復する
+func (action HealAction) Perform(game *Game) {
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || player.Health <= 0 {
+		return
+	}
+
+	amount := action.Amount
+	if amount > InitialHealth-player.Health {
+		amount = InitialHealth - player.Health
+	}
+	player.Health += amount
+	game.EventChannel <- HealEvent{
+		ID:     action.ID.String(),
+		Health: player.Health,
+		Amount: amount,
+	}
+	log.Printf("%v healed %v", player.Name, amount)
+}
+
+// 初期体力を上限にシールドを張る
+func (action ShieldAction) Perform(game *Game) {
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || player.Health <= 0 {
+		return
+	}
+
+	player.Shield += action.Amount
+	if player.Shield > InitialHealth {
+		player.Shield = InitialHealth
+	}
+	game.EventChannel <- ShieldEvent{
+		ID:     action.ID.String(),
+		Shield: player.Shield,
+	}
+	log.Printf("%v's shield is %v", player.Name, player.Shield)
+}
+
+func (action ProgressAction) Perform(game *Game) {
+	// 対戦中以外は無効
+	if game.State != Playing {
+		return
+	}
+
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || player.Health <= 0 {
+		return
+	}
+
+	// お題と一致しない入力は通知しない
+	percent, ok := game.Problem[action.ID].Peek().Progress(action.Text)
+	if !ok {
+		return
+	}
+	game.EventChannel <- ProgressEvent{
+		ID:      action.ID.String(),
+		Percent: percent,
+	}
+}
+
+func (action RematchAction) Perform(game *Game) {
+	// ゲーム終了後のみ有効
+	if game.State != Finished {
+		return
+	}
+	if _, ok := game.PlayerInfo[action.ID]; !ok {
+		return
+	}
+
+	game.RematchVote[action.ID] = true
+	game.EventChannel <- RematchVoteEvent{
+		Vote:     len(game.RematchVote),
+		Required: game.humans(),
+	}
+
+	// 全員が投票したら再戦を開始
+	game.checkRematch()
+}
+
+func (action SnapshotAction) Perform(game *Game) {
+	snapshot := SnapshotEvent{
+		ID:      action.ID,
+		State:   game.State,
+		Players: game.players(),
+	}
+	// 制限時間があれば残り時間も送る
+	if game.State == Playing && !game.deadline.IsZero() {
+		clock := game.clock()
+		snapshot.Clock = &clock
+	}
+
+	// 観戦者には対戦中の全員のお題を送る
+	if action.Spectator {
+		if game.State == Playing {
+			for _, id := range game.PlayerID {
+				if game.PlayerInfo[id].Health > 0 {
+					snapshot.Words = append(snapshot.Words, game.question(id))
+				}
+			}
+		}
+		game.EventChannel <- snapshot
+		return
+	}
+
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok {
+		return
+	}
+	// 対戦中なら現在のお題も送る
+	if game.State == Playing && player.Health > 0 {
+		question := game.question(action.ID)
+		snapshot.Question = &question
+	}
+	game.EventChannel <- snapshot
+}
+
+// プレイヤーの現在のお題
+func (g *Game) question(id uuid.UUID) QuestionEvent {
+	word := g.Problem[id].Peek()
+	effect := g.PlayerInfo[id].Effect
+	return QuestionEvent{
+		ID:      id,
+		Text:    word.Text,
+		Reading: word.Reading,
+		Damage:  effect.Amount(word),
+		Effect:  effect,
+	}
 }
 
 func (g *Game) Question(id uuid.UUID) {
-	g.EventChannel <- QuestionEvent{
-		ID:   id,
-		Text: g.Problem[id].Peek(),
+	g.PlayerInfo[id].Effect = rollEffect()
+	// ボットはお題を入力し終える時刻に攻撃する
+	if g.PlayerInfo[id].Bot {
+		g.scheduleBot(id, g.botDelay(id))
+	}
+	g.EventChannel <- g.question(id)
+	g.EventChannel <- ProgressEvent{
+		ID:      id.String(),
+		Percent: 0,
 	}
 }
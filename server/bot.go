@@ -0,0 +1,149 @@
+package server
+
+import (
+	"fmt"
+	"math/rand"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoRyuuuuu/typex/romaji"
+)
+
+// サーバが追加するボットの強さ
+type BotLevel int
+
+const (
+	EasyBot BotLevel = iota
+	NormalBot
+	HardBot
+)
+
+// 最初のプレイヤーが参加してから空き枠をボットで埋めるまでの時間。0ならボットを追加しない
+var BotWait time.Duration = 0
+
+// 追加するボットの強さ
+var BotDifficulty = NormalBot
+
+func (l BotLevel) String() string {
+	switch l {
+	case EasyBot:
+		return "easy"
+	case NormalBot:
+		return "normal"
+	case HardBot:
+		return "hard"
+	}
+	return "unknown"
+}
+
+func ParseBotLevel(s string) (BotLevel, error) {
+	switch s {
+	case "easy":
+		return EasyBot, nil
+	case "normal":
+		return NormalBot, nil
+	case "hard":
+		return HardBot, nil
+	}
+	return NormalBot, fmt.Errorf("unknown bot level %v", s)
+}
+
+// 1分あたりの入力単語数と打ち間違える確率
+func (l BotLevel) skill() (float64, float64) {
+	switch l {
+	case EasyBot:
+		return 25, 0.15
+	case HardBot:
+		return 70, 0.03
+	}
+	return 40, 0.08
+}
+
+// 待ち時間が過ぎたときに発行されるAction
+type fillAction struct {
+	wait int
+}
+
+// ボットがお題を入力し終えたときに発行されるAction
+type botAction struct {
+	ID   uuid.UUID
+	turn int
+}
+
+// 空き枠をボットで埋めるまでの待ちを始める
+func (g *Game) waitForBots() {
+	if BotWait <= 0 {
+		return
+	}
+	g.wait++
+	g.after(BotWait, fillAction{wait: g.wait})
+}
+
+func (action fillAction) Perform(game *Game) {
+	if game.State != Waiting || action.wait != game.wait || game.humans() == 0 {
+		return
+	}
+
+	for bot := 1; game.PlayerCount < game.Capacity; bot++ {
+		team, _ := game.assignTeam(0)
+		game.addPlayer(uuid.New(), fmt.Sprintf("Bot%v", bot), true, team, InitialHealth)
+	}
+	game.countdown()
+}
+
+// ボットが現在のお題を入力し終えるまでの時間
+func (g *Game) botDelay(id uuid.UUID) time.Duration {
+	word := g.Problem[id].Peek()
+	keys := len(word.Text)
+	if word.Reading != "" {
+		keys = len(romaji.Romanize(word.Reading))
+	}
+
+	wpm, _ := BotDifficulty.skill()
+	keystroke := float64(time.Minute) / (wpm * keystrokesPerWord)
+	return time.Duration(float64(keys) * keystroke * (0.7 + 0.6*rand.Float64()))
+}
+
+// delay後にボットが攻撃するよう予約する
+func (g *Game) scheduleBot(id uuid.UUID, delay time.Duration) {
+	player := g.PlayerInfo[id]
+	player.botTurn++
+	g.after(delay, botAction{ID: id, turn: player.botTurn})
+}
+
+func (action botAction) Perform(game *Game) {
+	if game.State != Playing {
+		return
+	}
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || !player.Bot || player.Health <= 0 || player.botTurn != action.turn {
+		return
+	}
+
+	// ペナルティで入力できない間は待つ
+	if remaining := time.Until(player.LockedUntil); remaining > 0 {
+		game.scheduleBot(action.ID, remaining)
+		return
+	}
+
+	// 攻撃できる敵がいなければ入力しない
+	if len(game.targets(action.ID)) == 0 {
+		return
+	}
+	word := game.Problem[action.ID].Peek()
+	text := word.Text
+	if word.Reading != "" {
+		text = romaji.Romanize(word.Reading)
+	}
+	_, errorRate := BotDifficulty.skill()
+	if rand.Float64() < errorRate {
+		text += "x"
+	}
+	// ボットは攻撃のたびにランダムに敵を選ぶ
+	AttackAction{ID: action.ID, Text: text, Mode: RandomTarget}.Perform(game)
+
+	// 打ち間違えてお題が変わらなかった場合はもう一度入力する
+	if game.State == Playing && player.Health > 0 && player.botTurn == action.turn {
+		game.scheduleBot(action.ID, game.botDelay(action.ID))
+	}
+}
@@ -0,0 +1,74 @@
+package server
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// この時間お題を入力しなければコンボが途切れる。0ならコンボなし
+var ComboTimeout = 5 * time.Second
+
+// 倍率が1上がるまでの連続正解数
+const comboStep = 5
+
+// ダメージ倍率の上限
+const maxComboMultiplier = 3
+
+// コンボが途切れる時刻に発行されるAction
+type comboAction struct {
+	ID    uuid.UUID
+	round int
+	turn  int
+}
+
+// 連続正解数に応じたダメージ倍率
+func (p *PlayerInfo) comboMultiplier() int {
+	multiplier := 1 + p.Combo/comboStep
+	if multiplier > maxComboMultiplier {
+		return maxComboMultiplier
+	}
+	return multiplier
+}
+
+// 正解したときにコンボを伸ばし、途切れる時刻を予約する
+func (g *Game) extendCombo(id uuid.UUID) {
+	if ComboTimeout <= 0 {
+		return
+	}
+	player := g.PlayerInfo[id]
+	player.Combo++
+	player.comboTurn++
+	g.after(ComboTimeout, comboAction{ID: id, round: g.round, turn: player.comboTurn})
+	g.EventChannel <- ComboEvent{
+		ID:         id.String(),
+		Combo:      player.Combo,
+		Multiplier: player.comboMultiplier(),
+	}
+}
+
+// 入力ミスや時間切れでコンボを途切れさせる
+func (g *Game) breakCombo(id uuid.UUID) {
+	player := g.PlayerInfo[id]
+	if player.Combo == 0 {
+		return
+	}
+	player.Combo = 0
+	player.comboTurn++
+	g.EventChannel <- ComboEvent{
+		ID:         id.String(),
+		Combo:      0,
+		Multiplier: 1,
+	}
+}
+
+func (action comboAction) Perform(game *Game) {
+	if game.State != Playing || action.round != game.round {
+		return
+	}
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || player.comboTurn != action.turn {
+		return
+	}
+	game.breakCombo(action.ID)
+}
@@ -0,0 +1,234 @@
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+)
+
+// 時間の長さ。設定ファイルでは"5s"や"2m"のような文字列で書く
+type Duration time.Duration
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("duration must be a string such as \"5s\": %s", data)
+	}
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(duration)
+	return nil
+}
+
+// ゲームのルール。設定ファイルのプリセット1つ分
+type Rules struct {
+	// デフォルトの部屋のプレイヤー数
+	Player int `json:"player"`
+	// デフォルトの部屋のチーム数。0なら個人戦
+	Teams int `json:"teams"`
+	// デフォルトのデータセット
+	Dataset string `json:"dataset"`
+	Health  int    `json:"health"`
+	Damage  string `json:"damage"`
+	Penalty string `json:"penalty"`
+	// 特殊なお題が出る確率
+	PowerUp      float64  `json:"powerup"`
+	ComboTimeout Duration `json:"combo_timeout"`
+	// 0なら制限時間なし
+	TimeLimit   Duration `json:"time_limit"`
+	SuddenDeath Duration `json:"sudden_death"`
+	// 全員揃ってからカウントダウンを始めるまでの時間
+	StartDelay Duration `json:"start_delay"`
+	// カウントダウンの長さ
+	Countdown Duration `json:"countdown"`
+	// メッセージを送らないプレイヤーを切断するまでの時間
+	ClientTimeout Duration `json:"client_timeout"`
+	// 0なら再接続を待たない
+	Reconnect Duration `json:"reconnect"`
+}
+
+// 組み込みのプリセット。デフォルトのルールから変える値だけを書く
+var builtinPresets = map[string]string{
+	// 体力が少なく制限時間のある短い対戦
+	"quick": `{
+		"health": 8,
+		"countdown": "3s",
+		"time_limit": "2m",
+		"sudden_death": "5s"
+	}`,
+	// 体力が多く制限時間のない長い対戦
+	"marathon": `{
+		"health": 40,
+		"combo_timeout": "10s",
+		"client_timeout": "30m",
+		"reconnect": "2m"
+	}`,
+	// 入力ミスに厳しく再接続できない対戦
+	"hardcore": `{
+		"health": 5,
+		"damage": "scaled",
+		"penalty": "self",
+		"combo_timeout": "3s",
+		"countdown": "3s",
+		"reconnect": "0s"
+	}`,
+}
+
+// 現在のルール。起動時はデフォルトのルール
+func CurrentRules() Rules {
+	return Rules{
+		Player:        PlayerCount,
+		Teams:         Teams,
+		Dataset:       DefaultDataset,
+		Health:        InitialHealth,
+		Damage:        DamageMode.String(),
+		Penalty:       PenaltyMode.String(),
+		PowerUp:       PowerUpRate,
+		ComboTimeout:  Duration(ComboTimeout),
+		TimeLimit:     Duration(TimeLimit),
+		SuddenDeath:   Duration(SuddenDeathInterval),
+		StartDelay:    Duration(startDelay),
+		Countdown:     Duration(countdownDuration),
+		ClientTimeout: Duration(clientTimeout),
+		Reconnect:     Duration(ReconnectGrace),
+	}
+}
+
+// 組み込みのプリセットと設定ファイルのプリセットを読み込む
+// 設定ファイルに同じ名前のプリセットがあればそちらを使う。pathが空の場合は組み込みのプリセットだけを返す
+func LoadPresets(path string) (map[string]Rules, error) {
+	raw := make(map[string]json.RawMessage)
+	for name, preset := range builtinPresets {
+		raw[name] = json.RawMessage(preset)
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		var config struct {
+			Presets map[string]json.RawMessage `json:"presets"`
+		}
+		if err := decodeStrict(data, &config); err != nil {
+			return nil, fmt.Errorf("%v: %w", path, err)
+		}
+		if len(config.Presets) == 0 {
+			return nil, fmt.Errorf("%v: no presets", path)
+		}
+		for name, preset := range config.Presets {
+			raw[name] = preset
+		}
+	}
+
+	presets := make(map[string]Rules)
+	for name, preset := range raw {
+		// 書かなかった値はデフォルトのルールのまま
+		rules := CurrentRules()
+		if err := decodeStrict(preset, &rules); err != nil {
+			return nil, fmt.Errorf("preset %v: %w", name, err)
+		}
+		if err := rules.validate(); err != nil {
+			return nil, fmt.Errorf("preset %v: %w", name, err)
+		}
+		presets[name] = rules
+	}
+	return presets, nil
+}
+
+// 知らないキーをエラーにしてJSONを読み込む
+func decodeStrict(data []byte, v interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(v)
+}
+
+// プリセットの名前の一覧
+func PresetNames(presets map[string]Rules) []string {
+	names := make([]string, 0, len(presets))
+	for name := range presets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func (r Rules) validate() error {
+	if r.Player <= 0 {
+		return fmt.Errorf("invalid number of players %v", r.Player)
+	}
+	if err := validateTeams(r.Teams, r.Player); err != nil {
+		return err
+	}
+	if !hasDataset(r.Dataset) {
+		return fmt.Errorf("unknown dataset %v (available: %v)", r.Dataset, DatasetNames())
+	}
+	if r.Health <= 0 {
+		return fmt.Errorf("invalid health %v", r.Health)
+	}
+	if _, err := ParseDamageModel(r.Damage); err != nil {
+		return err
+	}
+	if _, err := ParsePenalty(r.Penalty); err != nil {
+		return err
+	}
+	if r.PowerUp < 0 || r.PowerUp > 1 {
+		return fmt.Errorf("invalid power-up rate %v", r.PowerUp)
+	}
+	if r.ComboTimeout < 0 {
+		return fmt.Errorf("invalid combo timeout %v", time.Duration(r.ComboTimeout))
+	}
+	if r.TimeLimit < 0 {
+		return fmt.Errorf("invalid time limit %v", time.Duration(r.TimeLimit))
+	}
+	if r.SuddenDeath <= 0 {
+		return fmt.Errorf("invalid sudden death interval %v", time.Duration(r.SuddenDeath))
+	}
+	if r.StartDelay <= 0 {
+		return fmt.Errorf("invalid start delay %v", time.Duration(r.StartDelay))
+	}
+	if r.Countdown < 0 {
+		return fmt.Errorf("invalid countdown %v", time.Duration(r.Countdown))
+	}
+	if r.ClientTimeout <= 0 {
+		return fmt.Errorf("invalid client timeout %v", time.Duration(r.ClientTimeout))
+	}
+	if r.Reconnect < 0 {
+		return fmt.Errorf("invalid reconnect grace period %v", time.Duration(r.Reconnect))
+	}
+	return nil
+}
+
+// ルールを確かめてからサーバに設定する
+func (r Rules) Apply() error {
+	if err := r.validate(); err != nil {
+		return err
+	}
+	damage, _ := ParseDamageModel(r.Damage)
+	penalty, _ := ParsePenalty(r.Penalty)
+
+	PlayerCount = r.Player
+	Teams = r.Teams
+	DefaultDataset = r.Dataset
+	InitialHealth = r.Health
+	DamageMode = damage
+	PenaltyMode = penalty
+	PowerUpRate = r.PowerUp
+	ComboTimeout = time.Duration(r.ComboTimeout)
+	TimeLimit = time.Duration(r.TimeLimit)
+	SuddenDeathInterval = time.Duration(r.SuddenDeath)
+	startDelay = time.Duration(r.StartDelay)
+	countdownDuration = time.Duration(r.Countdown)
+	clientTimeout = time.Duration(r.ClientTimeout)
+	ReconnectGrace = time.Duration(r.Reconnect)
+	return nil
+}
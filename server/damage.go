@@ -0,0 +1,67 @@
+package server
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// ダメージの計算方法
+type DamageModel int
+
+const (
+	// 単語によらず1ダメージ
+	FlatDamage DamageModel = iota
+	// 単語の長さや難易度に応じたダメージ
+	ScaledDamage
+)
+
+var DamageMode = FlatDamage
+
+// 1ダメージあたりの打鍵数
+const keystrokesPerDamage = 4
+
+func (m DamageModel) String() string {
+	switch m {
+	case FlatDamage:
+		return "flat"
+	case ScaledDamage:
+		return "scaled"
+	}
+	return "unknown"
+}
+
+func ParseDamageModel(s string) (DamageModel, error) {
+	switch s {
+	case "flat":
+		return FlatDamage, nil
+	case "scaled":
+		return ScaledDamage, nil
+	}
+	return FlatDamage, fmt.Errorf("unknown damage model %v", s)
+}
+
+// 単語を入力したときに与えるダメージ
+func (m DamageModel) Damage(word Word) int {
+	if m == FlatDamage {
+		return 1
+	}
+	return word.Weight()
+}
+
+// 単語の重み
+// 難易度が指定されていればそれを使い、なければ打鍵数から計算する
+func (w Word) Weight() int {
+	if w.Difficulty > 0 {
+		return w.Difficulty
+	}
+	return (w.keystrokes() + keystrokesPerDamage - 1) / keystrokesPerDamage
+}
+
+// 単語の入力に必要なおおよその打鍵数
+// かなはローマ字で2打鍵として数える
+func (w Word) keystrokes() int {
+	if w.Reading == "" {
+		return utf8.RuneCountInString(w.Text)
+	}
+	return utf8.RuneCountInString(w.Reading) * 2
+}
@@ -1,24 +1,95 @@
 package server
 
 import (
+	"fmt"
 	"math/rand"
+	"sort"
+	"strings"
+
+	"github.com/yoRyuuuuu/typex/romaji"
 )
 
-var datasetSingleton *dataset = newDataset()
+// 部屋の作成時にデータセットを指定しなかった場合に使うデータセット名
+var DefaultDataset = "en"
+
+var datasets = map[string]*dataset{
+	"en": newDataset(englishWords(Words)),
+	"ja": newDataset(JapaneseWords),
+}
+
+// お題の単語
+type Word struct {
+	// 表示するテキスト
+	Text string
+	// ひらがなの読み。空の場合はTextをそのまま入力する
+	Reading string
+	// 難易度。0の場合は指定なし
+	Difficulty int
+	// 分類
+	Category string
+}
+
+// 入力が単語に一致するか判定する
+func (w Word) Match(input string) bool {
+	if w.Reading == "" {
+		return input == w.Text
+	}
+	return romaji.Match(w.Reading, input)
+}
+
+// 入力途中の文字列が単語の何%まで一致しているかを返す
+// 一致しない場合はfalseを返す
+func (w Word) Progress(input string) (int, bool) {
+	if w.Reading == "" {
+		if !strings.HasPrefix(w.Text, input) {
+			return 0, false
+		}
+		return len(input) * 100 / len(w.Text), true
+	}
+
+	reached, total, ok := romaji.Progress(w.Reading, input)
+	if !ok || total == 0 {
+		return 0, false
+	}
+	return reached * 100 / total, true
+}
 
 type dataset struct {
-	text []string
+	words []Word
 }
 
 type IIterator interface {
-	Peek() string
-	Next() string
+	Peek() Word
+	Next() Word
 }
 
-func newDataset() *dataset {
+func newDataset(words []Word) *dataset {
 	return &dataset{
-		text: Words,
+		words: words,
+	}
+}
+
+func englishWords(text []string) []Word {
+	words := make([]Word, 0, len(text))
+	for _, t := range text {
+		words = append(words, Word{Text: t})
+	}
+	return words
+}
+
+// 登録されているデータセット名の一覧
+func DatasetNames() []string {
+	names := make([]string, 0, len(datasets))
+	for name := range datasets {
+		names = append(names, name)
 	}
+	sort.Strings(names)
+	return names
+}
+
+func hasDataset(name string) bool {
+	_, ok := datasets[name]
+	return ok
 }
 
 type DatasetIterator struct {
@@ -26,25 +97,24 @@ type DatasetIterator struct {
 	dataset
 }
 
-func NewDatasetIterator() *DatasetIterator {
-	dataset := *datasetSingleton
-	index := rand.Intn(len(dataset.text))
+func NewDatasetIterator(name string) *DatasetIterator {
+	dataset, ok := datasets[name]
+	if !ok {
+		panic(fmt.Sprintf("unknown dataset %v", name))
+	}
+	index := rand.Intn(len(dataset.words))
 	return &DatasetIterator{
 		index:   index,
-		dataset: *datasetSingleton,
+		dataset: *dataset,
 	}
 }
 
-func (i *DatasetIterator) Peek() string {
-	return i.text[i.index]
-}
-
-func (i *DatasetIterator) Next() string {
-	text := i.text[i.index]
-	i.index = rand.Intn(len(i.text))
-	return text
+func (i *DatasetIterator) Peek() Word {
+	return i.words[i.index]
 }
 
-func NewDataset() *dataset {
-	return datasetSingleton
+func (i *DatasetIterator) Next() Word {
+	word := i.words[i.index]
+	i.index = rand.Intn(len(i.words))
+	return word
 }
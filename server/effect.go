@@ -0,0 +1,58 @@
+package server
+
+import (
+	"math/rand"
+)
+
+// お題を入力したときの効果
+type Effect int
+
+const (
+	// 攻撃対象にダメージを与える
+	NormalEffect Effect = iota
+	// 自分の体力を回復する
+	HealEffect
+	// 自分にダメージを防ぐシールドを張る
+	ShieldEffect
+	// 攻撃対象に2倍のダメージを与える
+	DoubleDamageEffect
+)
+
+// お題が特殊な効果を持つ確率。0なら通常のお題のみ
+var PowerUpRate = 0.0
+
+func (e Effect) String() string {
+	switch e {
+	case NormalEffect:
+		return "normal"
+	case HealEffect:
+		return "heal"
+	case ShieldEffect:
+		return "shield"
+	case DoubleDamageEffect:
+		return "double"
+	}
+	return "unknown"
+}
+
+// 攻撃対象が必要な効果か
+func (e Effect) attack() bool {
+	return e == NormalEffect || e == DoubleDamageEffect
+}
+
+// 次のお題の効果を決める
+func rollEffect() Effect {
+	if rand.Float64() >= PowerUpRate {
+		return NormalEffect
+	}
+	return Effect(1 + rand.Intn(3))
+}
+
+// 効果の量。回復量、シールドの量、またはダメージ
+func (e Effect) Amount(word Word) int {
+	damage := DamageMode.Damage(word)
+	if e == DoubleDamageEffect {
+		return damage * 2
+	}
+	return damage
+}
@@ -0,0 +1,90 @@
+package server
+
+import (
+	"errors"
+
+	"github.com/yoRyuuuuu/typex/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// クライアントへ返すエラー。Codeでエラーの種類を判別できる
+type GameError struct {
+	Code    proto.ErrorCode
+	Message string
+}
+
+func (e *GameError) Error() string {
+	return e.Message
+}
+
+var (
+	ErrRoomFull      = &GameError{Code: proto.ErrorCode_SERVER_FULL, Message: "The room is full"}
+	ErrTeamFull      = &GameError{Code: proto.ErrorCode_SERVER_FULL, Message: "The team is full"}
+	ErrGameStarted   = &GameError{Code: proto.ErrorCode_GAME_STARTED, Message: "The game has already started"}
+	ErrInvalidTarget = &GameError{Code: proto.ErrorCode_INVALID_TARGET, Message: "The target can not be attacked"}
+	ErrNotYourTurn   = &GameError{Code: proto.ErrorCode_NOT_YOUR_TURN, Message: "You can not attack now"}
+	ErrRateLimited   = &GameError{Code: proto.ErrorCode_RATE_LIMITED, Message: "Too many requests"}
+	ErrTeamNotFound  = &GameError{Code: proto.ErrorCode_INVALID_ARGUMENT, Message: "The team does not exist"}
+	ErrRoomNotFound  = &GameError{Code: proto.ErrorCode_NOT_FOUND, Message: "The room does not exist"}
+	ErrRoomClosed    = &GameError{Code: proto.ErrorCode_NOT_FOUND, Message: "The room is closed"}
+	// ストリームを切断した理由
+	ErrTimedOut       = &GameError{Code: proto.ErrorCode_TIMED_OUT, Message: "You have been timed out"}
+	ErrQueueFull      = &GameError{Code: proto.ErrorCode_QUEUE_FULL, Message: "The send queue is full"}
+	ErrConnectionLost = &GameError{Code: proto.ErrorCode_CONNECTION_LOST, Message: "The connection was lost"}
+)
+
+// 値が正しくないリクエストのエラー
+func invalidArgument(err error) *GameError {
+	return &GameError{Code: proto.ErrorCode_INVALID_ARGUMENT, Message: err.Error()}
+}
+
+// バージョンに互換性がないクライアントのエラー
+func incompatible(message string) *GameError {
+	return &GameError{Code: proto.ErrorCode_INCOMPATIBLE_VERSION, Message: message}
+}
+
+// エラーの種類に対応するgRPCのステータスコード
+func (e *GameError) grpcCode() codes.Code {
+	switch e.Code {
+	case proto.ErrorCode_SERVER_FULL, proto.ErrorCode_RATE_LIMITED, proto.ErrorCode_QUEUE_FULL:
+		return codes.ResourceExhausted
+	case proto.ErrorCode_GAME_STARTED, proto.ErrorCode_NOT_YOUR_TURN, proto.ErrorCode_INCOMPATIBLE_VERSION:
+		return codes.FailedPrecondition
+	case proto.ErrorCode_INVALID_TARGET, proto.ErrorCode_INVALID_ARGUMENT:
+		return codes.InvalidArgument
+	case proto.ErrorCode_NOT_FOUND:
+		return codes.NotFound
+	case proto.ErrorCode_TIMED_OUT:
+		return codes.DeadlineExceeded
+	case proto.ErrorCode_CONNECTION_LOST:
+		return codes.Unavailable
+	}
+	return codes.Unknown
+}
+
+func protoError(err *GameError) *proto.Error {
+	return &proto.Error{
+		Code:    err.Code,
+		Message: err.Message,
+	}
+}
+
+// RPCで返すエラー。GameErrorはエラーの種類を詳細に付ける
+func statusError(err error) error {
+	var gameErr *GameError
+	if !errors.As(err, &gameErr) {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	st := status.New(gameErr.grpcCode(), gameErr.Message)
+	if detailed, detailErr := st.WithDetails(protoError(gameErr)); detailErr == nil {
+		st = detailed
+	}
+	return st.Err()
+}
+
+// ストリームの認証に失敗したエラー
+func unauthenticated(message string) error {
+	return status.Error(codes.Unauthenticated, message)
+}
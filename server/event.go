@@ -1,34 +1,200 @@
 package server
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type Event interface{}
 
 type FinishEvent struct {
 	Event
 	Winner string
+	// 各プレイヤーの成績
+	Results []PlayerResult
+}
+
+type PlayerResult struct {
+	ID   string
+	Name string
+	// 順位
+	Rank int
+	// 入力に成功した回数
+	Hit int
+	// 入力ミスの回数
+	Miss int
+	// 与えたダメージの合計
+	DamageDealt int
+	// 受けたダメージの合計
+	DamageTaken int
+	// 1分あたりの入力単語数(5打鍵を1単語とする)
+	WPM float64
+	// 対戦開始から脱落または終了までの時間
+	Survived time.Duration
 }
 
 type QuestionEvent struct {
 	Event
 	ID   uuid.UUID
 	Text string
+	// ひらがなの読み。英単語の場合は空
+	Reading string
+	// 入力に成功したときに与えるダメージ。回復とシールドのお題ではその量
+	Damage int
+	Effect Effect
 }
 
 type StartEvent struct {
 	Event
+	// 開始時点のプレイヤー情報
+	Players []Player
+	// 最初のお題が出るまでの時間
+	Countdown time.Duration
 }
 
 type DamageEvent struct {
 	Event
 	// 攻撃を受けるPlayerのID
 	ID string
-	// ダメージの数値
+	// 攻撃を受けた後の体力
 	Damage int
+	// 与えたダメージ
+	Amount int
+}
+
+type HealEvent struct {
+	Event
+	// 回復したPlayerのID
+	ID string
+	// 回復した後の体力
+	Health int
+	// 回復量
+	Amount int
+}
+
+type ShieldEvent struct {
+	Event
+	ID string
+	// 残っているシールド
+	Shield int
+}
+
+// 操作を受け付けなかったことの通知
+type ErrorEvent struct {
+	Event
+	// 送り先のID
+	ID  uuid.UUID
+	Err *GameError
+}
+
+// プレイヤーの攻撃対象の変化
+type TargetChangedEvent struct {
+	Event
+	ID string
+	// 新しい攻撃対象のID。攻撃対象がいなくなった場合は空
+	Target string
+}
+
+// 連続正解数の変化
+type ComboEvent struct {
+	Event
+	ID    string
+	Combo int
+	// ダメージ倍率
+	Multiplier int
 }
 
 type JoinEvent struct {
 	Event
-	ID   string
-	Name string
+	ID     string
+	Name   string
+	Health int
+	Bot    bool
+	Team   int
+}
+
+// 攻撃の結果Event
+type AttackResultEvent struct {
+	Event
+	// 攻撃したPlayerのID
+	ID  uuid.UUID
+	Hit bool
+	// 入力ミスで受けたペナルティ
+	Penalty Penalty
+	// 入力を受け付けない残り時間
+	Lockout time.Duration
+}
+
+// 入力の進捗Event
+type ProgressEvent struct {
+	Event
+	// 入力しているPlayerのID
+	ID string
+	// お題の何%まで入力したか
+	Percent int
+}
+
+// 体力が0になったPlayerのEvent
+type EliminatedEvent struct {
+	Event
+	ID string
+}
+
+// 切断したPlayerのEvent
+type LeaveEvent struct {
+	Event
+	ID string
+}
+
+// ゲームの状態遷移Event
+type StateEvent struct {
+	Event
+	State State
+}
+
+// 再接続したPlayerまたは観戦者へ送る現在の状態
+type SnapshotEvent struct {
+	Event
+	// 送り先のID
+	ID      uuid.UUID
+	State   State
+	Players []Player
+	// 対戦中でなければnil
+	Question *QuestionEvent
+	// 観戦者へ送る各Playerのお題
+	Words []QuestionEvent
+	// 制限時間がなければnil
+	Clock *ClockEvent
+}
+
+// 制限時間の通知。開始時、サドンデス開始時、倍率が上がるたびに送る
+type ClockEvent struct {
+	Event
+	// 制限時間、またはサドンデスで次に倍率が上がるまでの時間
+	Remaining   time.Duration
+	SuddenDeath bool
+	// サドンデスのダメージ倍率
+	Multiplier int
+}
+
+type RematchVoteEvent struct {
+	Event
+	// 再戦に投票したプレイヤー数
+	Vote int
+	// 再戦に必要な投票数
+	Required int
+}
+
+type Player struct {
+	ID     string
+	Name   string
+	Health int
+	Bot    bool
+	// 0ならチームなし
+	Team   int
+	Shield int
+	Combo  int
+	// 攻撃対象のID。決まっていなければ空
+	Target string
 }
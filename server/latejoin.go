@@ -0,0 +1,47 @@
+package server
+
+import "fmt"
+
+// ゲーム開始後に参加しようとしたプレイヤーへの対応
+type LateJoinPolicy int
+
+const (
+	// 参加を断る
+	RejectLateJoin LateJoinPolicy = iota
+	// 観戦者として部屋に入れる
+	SpectateLateJoin
+	// プレイヤーとして参加させる。対戦中なら体力を減らしてすぐにお題を出す
+	PlayLateJoin
+)
+
+// ゲーム開始後の参加への対応
+var LateJoin = RejectLateJoin
+
+func (p LateJoinPolicy) String() string {
+	switch p {
+	case RejectLateJoin:
+		return "reject"
+	case SpectateLateJoin:
+		return "spectate"
+	case PlayLateJoin:
+		return "play"
+	}
+	return "unknown"
+}
+
+func ParseLateJoinPolicy(s string) (LateJoinPolicy, error) {
+	switch s {
+	case "reject":
+		return RejectLateJoin, nil
+	case "spectate":
+		return SpectateLateJoin, nil
+	case "play":
+		return PlayLateJoin, nil
+	}
+	return RejectLateJoin, fmt.Errorf("unknown late join policy %v", s)
+}
+
+// 途中参加したプレイヤーの体力。初期体力の半分(切り上げ)
+func lateJoinHealth() int {
+	return (InitialHealth + 1) / 2
+}
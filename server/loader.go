@@ -0,0 +1,272 @@
+package server
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/yoRyuuuuu/typex/romaji"
+)
+
+// ファイルまたはディレクトリから単語リストを読み込んでデータセットとして登録する
+// データセット名は拡張子を除いたファイル名になる
+// 対応する形式は .txt .json .csv
+func LoadDatasets(path string) ([]string, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{path}
+	if info.IsDir() {
+		files, err = datasetFiles(path)
+		if err != nil {
+			return nil, err
+		}
+		if len(files) == 0 {
+			return nil, fmt.Errorf("%v: no word lists found", path)
+		}
+	}
+
+	loaded := make(map[string]*dataset)
+	names := []string{}
+	for _, file := range files {
+		name := datasetName(file)
+		if _, ok := datasets[name]; ok {
+			return nil, fmt.Errorf("%v: dataset %v is already registered", file, name)
+		}
+		if _, ok := loaded[name]; ok {
+			return nil, fmt.Errorf("%v: duplicate dataset name %v", file, name)
+		}
+
+		words, err := loadWords(file)
+		if err != nil {
+			return nil, err
+		}
+		loaded[name] = newDataset(words)
+		names = append(names, name)
+	}
+
+	// 全てのファイルの読み込みに成功してから登録する
+	for name, dataset := range loaded {
+		datasets[name] = dataset
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+func datasetFiles(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	files := []string{}
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		switch filepath.Ext(entry.Name()) {
+		case ".txt", ".json", ".csv":
+			files = append(files, filepath.Join(dir, entry.Name()))
+		}
+	}
+	return files, nil
+}
+
+func datasetName(path string) string {
+	base := filepath.Base(path)
+	return strings.TrimSuffix(base, filepath.Ext(base))
+}
+
+func loadWords(path string) ([]Word, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var words []Word
+	switch filepath.Ext(path) {
+	case ".txt":
+		words, err = parseText(file)
+	case ".json":
+		words, err = parseJSON(file)
+	case ".csv":
+		words, err = parseCSV(file)
+	default:
+		err = errors.New("unsupported format, use .txt, .json or .csv")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+
+	if err := validateWords(words); err != nil {
+		return nil, fmt.Errorf("%v: %v", path, err)
+	}
+	return words, nil
+}
+
+// 1行に1単語。タブ区切りで読みを指定できる
+// 空行と#で始まる行は無視する
+func parseText(r io.Reader) ([]Word, error) {
+	words := []Word{}
+	scanner := bufio.NewScanner(r)
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+
+		fields := strings.Split(text, "\t")
+		if len(fields) > 2 {
+			return nil, fmt.Errorf("line %v: too many fields", line)
+		}
+		word := Word{Text: strings.TrimSpace(fields[0])}
+		if len(fields) == 2 {
+			word.Reading = strings.TrimSpace(fields[1])
+		}
+		words = append(words, word)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return words, nil
+}
+
+type jsonWord struct {
+	Text       string `json:"text"`
+	Reading    string `json:"reading"`
+	Difficulty int    `json:"difficulty"`
+	Category   string `json:"category"`
+}
+
+// 文字列または単語オブジェクトの配列
+func parseJSON(r io.Reader) ([]Word, error) {
+	var raw []json.RawMessage
+	if err := json.NewDecoder(r).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	words := []Word{}
+	for i, message := range raw {
+		var text string
+		if err := json.Unmarshal(message, &text); err == nil {
+			words = append(words, Word{Text: text})
+			continue
+		}
+
+		var word jsonWord
+		if err := json.Unmarshal(message, &word); err != nil {
+			return nil, fmt.Errorf("entry %v: %v", i, err)
+		}
+		words = append(words, Word{
+			Text:       word.Text,
+			Reading:    word.Reading,
+			Difficulty: word.Difficulty,
+			Category:   word.Category,
+		})
+	}
+	return words, nil
+}
+
+var csvColumns = []string{"text", "reading", "difficulty", "category"}
+
+// 列は text, reading, difficulty, category の順
+// 1行目が列名の場合はその順序に従う
+func parseCSV(r io.Reader) ([]Word, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	columns := csvColumns
+	if len(records) > 0 && strings.EqualFold(records[0][0], "text") {
+		columns = records[0]
+		for _, column := range columns {
+			if !isCSVColumn(column) {
+				return nil, fmt.Errorf("line 1: unknown column %v", column)
+			}
+		}
+		records = records[1:]
+	}
+
+	words := []Word{}
+	for i, record := range records {
+		if len(record) > len(columns) {
+			return nil, fmt.Errorf("record %v: too many fields", i+1)
+		}
+
+		word := Word{}
+		for j, value := range record {
+			value = strings.TrimSpace(value)
+			switch strings.ToLower(columns[j]) {
+			case "text":
+				word.Text = value
+			case "reading":
+				word.Reading = value
+			case "difficulty":
+				if value == "" {
+					continue
+				}
+				difficulty, err := strconv.Atoi(value)
+				if err != nil {
+					return nil, fmt.Errorf("record %v: invalid difficulty %q", i+1, value)
+				}
+				word.Difficulty = difficulty
+			case "category":
+				word.Category = value
+			}
+		}
+		words = append(words, word)
+	}
+	return words, nil
+}
+
+func isCSVColumn(column string) bool {
+	for _, c := range csvColumns {
+		if strings.EqualFold(c, column) {
+			return true
+		}
+	}
+	return false
+}
+
+func validateWords(words []Word) error {
+	if len(words) == 0 {
+		return errors.New("word list is empty")
+	}
+
+	seen := make(map[string]bool)
+	for i, word := range words {
+		if word.Text == "" {
+			return fmt.Errorf("word %v: text is empty", i+1)
+		}
+		if word.Difficulty < 0 {
+			return fmt.Errorf("word %v (%v): difficulty must not be negative", i+1, word.Text)
+		}
+		if word.Reading != "" && !romaji.IsTypeable(word.Reading) {
+			return fmt.Errorf("word %v (%v): reading %v must be written in kana", i+1, word.Text, word.Reading)
+		}
+
+		key := word.Text + "\x00" + word.Reading
+		if seen[key] {
+			return fmt.Errorf("word %v (%v): duplicate word", i+1, word.Text)
+		}
+		seen[key] = true
+	}
+	return nil
+}
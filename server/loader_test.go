@@ -0,0 +1,138 @@
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseText(t *testing.T) {
+	input := "# comment\napple\n\n  banana  \n寿司\tすし\n"
+	want := []Word{
+		{Text: "apple"},
+		{Text: "banana"},
+		{Text: "寿司", Reading: "すし"},
+	}
+	got, err := parseText(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseText: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseText = %v, want %v", got, want)
+	}
+
+	if _, err := parseText(strings.NewReader("a\tb\tc\n")); err == nil {
+		t.Error("parseText accepted a line with too many fields")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	input := `["apple", {"text": "寿司", "reading": "すし", "difficulty": 2, "category": "food"}]`
+	want := []Word{
+		{Text: "apple"},
+		{Text: "寿司", Reading: "すし", Difficulty: 2, Category: "food"},
+	}
+	got, err := parseJSON(strings.NewReader(input))
+	if err != nil {
+		t.Fatalf("parseJSON: %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("parseJSON = %v, want %v", got, want)
+	}
+
+	for _, input := range []string{`{"text": "apple"}`, `["apple", 1]`, `["apple"`} {
+		if _, err := parseJSON(strings.NewReader(input)); err == nil {
+			t.Errorf("parseJSON(%q) returned no error", input)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  []Word
+	}{
+		{
+			name:  "default columns",
+			input: "寿司,すし,2,food\napple\n",
+			want: []Word{
+				{Text: "寿司", Reading: "すし", Difficulty: 2, Category: "food"},
+				{Text: "apple"},
+			},
+		},
+		{
+			name:  "reordered header",
+			input: "Text,Category,Difficulty\napple,fruit,1\nbanana,fruit,\n",
+			want: []Word{
+				{Text: "apple", Difficulty: 1, Category: "fruit"},
+				{Text: "banana", Category: "fruit"},
+			},
+		},
+	}
+	for _, tt := range tests {
+		got, err := parseCSV(strings.NewReader(tt.input))
+		if err != nil {
+			t.Errorf("%v: parseCSV: %v", tt.name, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%v: parseCSV = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	invalid := []string{
+		"text,level\napple,1\n",
+		"apple,,hard\n",
+		"apple,,1,fruit,extra\n",
+		"text\napple,banana\n",
+		"\"apple\n",
+	}
+	for _, input := range invalid {
+		if _, err := parseCSV(strings.NewReader(input)); err == nil {
+			t.Errorf("parseCSV(%q) returned no error", input)
+		}
+	}
+}
+
+func TestValidateWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		words []Word
+		ok    bool
+	}{
+		{"valid", []Word{{Text: "apple"}, {Text: "寿司", Reading: "すし"}}, true},
+		{"same text with another reading", []Word{{Text: "日", Reading: "ひ"}, {Text: "日", Reading: "にち"}}, true},
+		{"empty", []Word{}, false},
+		{"duplicate", []Word{{Text: "apple"}, {Text: "apple"}}, false},
+		{"empty text", []Word{{Text: ""}}, false},
+		{"negative difficulty", []Word{{Text: "apple", Difficulty: -1}}, false},
+		{"reading not in kana", []Word{{Text: "寿司", Reading: "寿司"}}, false},
+	}
+	for _, tt := range tests {
+		err := validateWords(tt.words)
+		if (err == nil) != tt.ok {
+			t.Errorf("%v: validateWords = %v, want ok=%v", tt.name, err, tt.ok)
+		}
+	}
+}
+
+func TestLoadWords(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"empty.txt":     "# nothing\n\n",
+		"duplicate.txt": "apple\napple\n",
+		"words.yaml":    "- apple\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := loadWords(path); err == nil {
+			t.Errorf("loadWords(%v) returned no error", name)
+		}
+	}
+}
@@ -0,0 +1,145 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 誰もいないまま開始前の部屋を片付けるまでの時間。0なら片付けない
+var RoomIdleTimeout = 5 * time.Minute
+
+// 複数の部屋を管理するロビー
+type Lobby struct {
+	rooms       map[string]*Room
+	defaultRoom *Room
+	nextID      int
+	mu          sync.RWMutex
+}
+
+func NewLobby() (*Lobby, error) {
+	lobby := &Lobby{
+		rooms:  make(map[string]*Room),
+		nextID: 1,
+	}
+	room, err := lobby.CreateRoom("default", PlayerCount, DefaultDataset, Teams)
+	if err != nil {
+		return nil, err
+	}
+	lobby.defaultRoom = room
+	go lobby.watchIdleRooms()
+	return lobby, nil
+}
+
+// capacityが0以下の場合はPlayerCount、datasetが空の場合はDefaultDatasetを使う
+// teamsが0の場合は個人戦
+func (l *Lobby) CreateRoom(name string, capacity int, dataset string, teams int) (*Room, error) {
+	if capacity <= 0 {
+		capacity = PlayerCount
+	}
+	if dataset == "" {
+		dataset = DefaultDataset
+	}
+	if !hasDataset(dataset) {
+		return nil, invalidArgument(fmt.Errorf("unknown dataset %v (available: %v)", dataset, DatasetNames()))
+	}
+	if err := validateTeams(teams, capacity); err != nil {
+		return nil, invalidArgument(err)
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	id := strconv.Itoa(l.nextID)
+	l.nextID++
+	room := NewRoom(id, name, capacity, dataset, teams)
+	l.rooms[id] = room
+	return room, nil
+}
+
+// IDが空文字列の場合はデフォルトの部屋を返す
+func (l *Lobby) Room(id string) (*Room, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	if id == "" {
+		return l.defaultRoom, true
+	}
+	room, ok := l.rooms[id]
+	return room, ok
+}
+
+// ID順に並べた部屋の一覧を返す
+func (l *Lobby) Rooms() []*Room {
+	l.mu.RLock()
+	rooms := make([]*Room, 0, len(l.rooms))
+	for _, room := range l.rooms {
+		rooms = append(rooms, room)
+	}
+	l.mu.RUnlock()
+
+	sort.Slice(rooms, func(i, j int) bool {
+		a, _ := strconv.Atoi(rooms[i].ID)
+		b, _ := strconv.Atoi(rooms[j].ID)
+		return a < b
+	})
+	return rooms
+}
+
+// tokenに対応するプレイヤーが参加している部屋を探す
+func (l *Lobby) lookup(token uuid.UUID) (*Room, *client, bool) {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	for _, room := range l.rooms {
+		if clt, ok := room.client(token); ok {
+			return room, clt, true
+		}
+	}
+	return nil, nil, false
+}
+
+// 誰もいなくなった部屋を片付ける
+// 開始前の部屋はRoomIdleTimeoutの間誰もいなかった場合だけ片付け、デフォルトの部屋は残す
+func (l *Lobby) release(room *Room) {
+	if !room.empty() {
+		return
+	}
+	waiting := room.game.CurrentState() == Waiting
+	if waiting && (RoomIdleTimeout <= 0 || room.idle() < RoomIdleTimeout) {
+		return
+	}
+
+	l.mu.Lock()
+	// すでに解放済み
+	if l.rooms[room.ID] != room || (waiting && room == l.defaultRoom) {
+		l.mu.Unlock()
+		return
+	}
+	delete(l.rooms, room.ID)
+	if room == l.defaultRoom {
+		id := strconv.Itoa(l.nextID)
+		l.nextID++
+		l.defaultRoom = NewRoom(id, room.Name, room.game.Capacity, room.game.Dataset, room.game.Teams)
+		l.rooms[id] = l.defaultRoom
+	}
+	l.mu.Unlock()
+	room.close()
+	log.Printf("release room [ID: %v, Name: %v]", room.ID, room.Name)
+}
+
+// 誰もいない部屋を定期的に片付ける
+func (l *Lobby) watchIdleRooms() {
+	if RoomIdleTimeout <= 0 {
+		return
+	}
+	ticker := time.NewTicker(RoomIdleTimeout / 2)
+	defer ticker.Stop()
+	for range ticker.C {
+		for _, room := range l.Rooms() {
+			l.release(room)
+		}
+	}
+}
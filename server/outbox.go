@@ -0,0 +1,294 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/yoRyuuuuu/typex/proto"
+)
+
+// 送信キューが一杯になったクライアントへの対応
+type SlowConsumerPolicy int
+
+const (
+	// 溢れたEventを捨てる
+	DropPolicy SlowConsumerPolicy = iota
+	// ダメージと進捗は同じプレイヤーの最新の値にまとめて後で送り、それ以外が溢れたら接続を切る
+	CoalescePolicy
+	// 接続を切る。再接続すると現在の状態が送られる
+	DisconnectPolicy
+)
+
+// 送信キューが一杯になったときの対応
+var SlowConsumer = DisconnectPolicy
+
+// クライアントごとの送信キューの長さ
+var QueueSize = 256
+
+// 送信キューの状態をログに出す間隔。0なら出さない
+var MetricsInterval time.Duration = 0
+
+func (p SlowConsumerPolicy) String() string {
+	switch p {
+	case DropPolicy:
+		return "drop"
+	case CoalescePolicy:
+		return "coalesce"
+	case DisconnectPolicy:
+		return "disconnect"
+	}
+	return "unknown"
+}
+
+func ParseSlowConsumerPolicy(s string) (SlowConsumerPolicy, error) {
+	switch s {
+	case "drop":
+		return DropPolicy, nil
+	case "coalesce":
+		return CoalescePolicy, nil
+	case "disconnect":
+		return DisconnectPolicy, nil
+	}
+	return DisconnectPolicy, fmt.Errorf("unknown slow consumer policy %v", s)
+}
+
+// クライアントごとの送信キュー
+// ストリームの接続中は専用のgoroutineがキューから順に送信する
+type outbox struct {
+	name string
+	mu   sync.Mutex
+	// 接続中のみ。切断中に送ろうとしたEventは捨てる
+	queue chan *proto.Response
+	done  chan error
+	// 送信を止める。最後に送るEventがあれば渡す
+	stop chan *proto.Response
+	// 送信goroutineが終わると閉じる
+	finished chan struct{}
+	// まとめたEventがあることを送信goroutineへ知らせる
+	wake chan struct{}
+	// キューが一杯のときにまとめたEvent。キーはEventの種類とプレイヤーID
+	pending map[string]*proto.Response
+	keys    []string
+	// キューの最大の深さ
+	peak int
+	// 捨てたEventの数
+	dropped int
+}
+
+func newOutbox(name string) *outbox {
+	return &outbox{
+		name:    name,
+		pending: make(map[string]*proto.Response),
+	}
+}
+
+// ストリームを接続して送信を始める。送信に失敗した場合はdoneへ通知する
+func (o *outbox) open(srv proto.Game_StreamServer, done chan error) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.queue = make(chan *proto.Response, QueueSize)
+	o.done = done
+	o.stop = make(chan *proto.Response, 1)
+	o.finished = make(chan struct{})
+	o.wake = make(chan struct{}, 1)
+	o.pending = make(map[string]*proto.Response)
+	o.keys = nil
+	go o.run(srv, o.queue, o.wake, o.stop, done, o.finished)
+}
+
+// 送信を止める。キューに残ったEventは捨て、lastがあれば最後に送る
+// 送信goroutineが終わると閉じるチャネルを返す
+func (o *outbox) close(last *proto.Response) <-chan struct{} {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.queue == nil {
+		finished := make(chan struct{})
+		close(finished)
+		return finished
+	}
+	o.stop <- last
+	o.queue = nil
+	return o.finished
+}
+
+// Eventを送信キューに入れる。キューが一杯の場合はSlowConsumerに従う
+func (o *outbox) send(res *proto.Response) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.queue == nil {
+		return
+	}
+
+	key := coalesceKey(res)
+	if SlowConsumer == CoalescePolicy {
+		// まとめたEventがある間は同じキーのEventもまとめ、古い値が後から届かないようにする
+		if _, ok := o.pending[key]; ok && key != "" {
+			o.coalesce(key, res)
+			return
+		}
+		// まとめられないEventより前に、まとめたEventをキューへ戻して順番を保つ
+		if key == "" && !o.flushPending(res) {
+			o.disconnect()
+			return
+		}
+	}
+
+	select {
+	case o.queue <- res:
+		if depth := len(o.queue); depth > o.peak {
+			o.peak = depth
+		}
+		return
+	default:
+	}
+
+	switch SlowConsumer {
+	case CoalescePolicy:
+		// まとめられないEventは捨てるとプレイヤーの状態がずれるので接続を切る
+		if key != "" {
+			o.coalesce(key, res)
+			return
+		}
+		o.disconnect()
+		return
+	case DisconnectPolicy:
+		o.disconnect()
+		return
+	}
+	o.dropped++
+}
+
+// まとめたEventをキューの末尾へ移す。後に続くresも入る空きがなければ何もせずfalseを返す
+func (o *outbox) flushPending(res *proto.Response) bool {
+	if len(o.keys) == 0 {
+		return true
+	}
+	if cap(o.queue)-len(o.queue) < len(o.keys)+1 {
+		return false
+	}
+	for _, key := range o.keys {
+		o.queue <- o.pending[key]
+		delete(o.pending, key)
+	}
+	o.keys = nil
+	return true
+}
+
+// 送信キューが溢れたクライアントの接続を切る。再接続すると現在の状態が送られる
+func (o *outbox) disconnect() {
+	select {
+	case o.done <- ErrQueueFull:
+		log.Printf("disconnect slow consumer %v", o.name)
+	default:
+	}
+	o.dropped++
+}
+
+// まとめられるEventのキー。まとめられない場合は空
+func coalesceKey(res *proto.Response) string {
+	switch event := res.GetEvent().(type) {
+	case *proto.Response_Damage:
+		return "damage:" + event.Damage.GetId()
+	case *proto.Response_Progress:
+		return "progress:" + event.Progress.GetId()
+	}
+	return ""
+}
+
+// 同じプレイヤーのEventを最新の値にまとめる。ダメージ量は合計する
+func (o *outbox) coalesce(key string, res *proto.Response) {
+	old, ok := o.pending[key]
+	if !ok {
+		o.keys = append(o.keys, key)
+	}
+	if ok && old.GetDamage() != nil && res.GetDamage() != nil {
+		res = &proto.Response{
+			Event: &proto.Response_Damage{
+				Damage: &proto.Damage{
+					Id:     res.GetDamage().GetId(),
+					Health: res.GetDamage().GetHealth(),
+					Amount: old.GetDamage().GetAmount() + res.GetDamage().GetAmount(),
+				},
+			},
+		}
+	}
+	o.pending[key] = res
+
+	select {
+	case o.wake <- struct{}{}:
+	default:
+	}
+}
+
+// まとめたEventを古い順に1つ取り出す
+func (o *outbox) takePending() *proto.Response {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if len(o.keys) == 0 {
+		return nil
+	}
+	key := o.keys[0]
+	o.keys = o.keys[1:]
+	res := o.pending[key]
+	delete(o.pending, key)
+	return res
+}
+
+func (o *outbox) run(srv proto.Game_StreamServer, queue chan *proto.Response, wake chan struct{}, stop chan *proto.Response, done chan error, finished chan struct{}) {
+	defer close(finished)
+	for {
+		select {
+		case last := <-stop:
+			o.sendLast(srv, last)
+			return
+		default:
+		}
+
+		var res *proto.Response
+		select {
+		case res = <-queue:
+		default:
+			// キューが空になってからまとめたEventを送る
+			res = o.takePending()
+		}
+
+		if res == nil {
+			select {
+			case res = <-queue:
+			case <-wake:
+				continue
+			case last := <-stop:
+				o.sendLast(srv, last)
+				return
+			}
+		}
+
+		if err := srv.Send(res); err != nil {
+			log.Printf("failed to send event to %v: %v", o.name, err)
+			select {
+			case done <- ErrConnectionLost:
+			default:
+			}
+			return
+		}
+	}
+}
+
+// 切断する理由などを最後に送る
+func (o *outbox) sendLast(srv proto.Game_StreamServer, last *proto.Response) {
+	if last == nil {
+		return
+	}
+	if err := srv.Send(last); err != nil {
+		log.Printf("failed to send last event to %v: %v", o.name, err)
+	}
+}
+
+// 現在のキューの深さ、最大の深さ、捨てたEventの数
+func (o *outbox) stats() (int, int, int) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return len(o.queue), o.peak, o.dropped
+}
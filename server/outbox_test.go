@@ -0,0 +1,209 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/yoRyuuuuu/typex/proto"
+)
+
+// 送信を1つずつ止められるストリーム
+type blockingStream struct {
+	proto.Game_StreamServer
+	sent    chan *proto.Response
+	release chan struct{}
+}
+
+func (s *blockingStream) Send(res *proto.Response) error {
+	s.sent <- res
+	<-s.release
+	return nil
+}
+
+func damageResponse(health int) *proto.Response {
+	return &proto.Response{
+		Event: &proto.Response_Damage{
+			Damage: &proto.Damage{Id: "p", Health: int64(health), Amount: 1},
+		},
+	}
+}
+
+func TestOutboxCoalesceKeepsOrder(t *testing.T) {
+	defer func(policy SlowConsumerPolicy, size int) {
+		SlowConsumer = policy
+		QueueSize = size
+	}(SlowConsumer, QueueSize)
+	SlowConsumer = CoalescePolicy
+	QueueSize = 1
+
+	stream := &blockingStream{
+		sent:    make(chan *proto.Response),
+		release: make(chan struct{}),
+	}
+	o := newOutbox("test")
+	o.open(stream, make(chan error, 1))
+	defer o.close(nil)
+
+	healths := []int{}
+	next := func() {
+		res := <-stream.sent
+		healths = append(healths, int(res.GetDamage().GetHealth()))
+	}
+
+	o.send(damageResponse(9))
+	next()
+	// 9を送信中に8がキューに入り、7はまとめられる
+	o.send(damageResponse(8))
+	o.send(damageResponse(7))
+	stream.release <- struct{}{}
+	next()
+	// キューは空いたが、まとめた7より新しい6もまとめなければならない
+	o.send(damageResponse(6))
+	stream.release <- struct{}{}
+
+	for {
+		select {
+		case res := <-stream.sent:
+			healths = append(healths, int(res.GetDamage().GetHealth()))
+			stream.release <- struct{}{}
+			continue
+		case <-time.After(200 * time.Millisecond):
+		}
+		break
+	}
+
+	for i := 1; i < len(healths); i++ {
+		if healths[i] >= healths[i-1] {
+			t.Fatalf("events arrived out of order: %v", healths)
+		}
+	}
+	if last := healths[len(healths)-1]; last != 6 {
+		t.Fatalf("last health = %v, want 6 (%v)", last, healths)
+	}
+}
+
+func healResponse(health int) *proto.Response {
+	return &proto.Response{
+		Event: &proto.Response_Heal{
+			Heal: &proto.Heal{Id: "p", Health: int64(health), Amount: 1},
+		},
+	}
+}
+
+func TestOutboxCoalesceSendsPendingBeforeOtherEvents(t *testing.T) {
+	defer func(policy SlowConsumerPolicy, size int) {
+		SlowConsumer = policy
+		QueueSize = size
+	}(SlowConsumer, QueueSize)
+	SlowConsumer = CoalescePolicy
+	QueueSize = 2
+
+	stream := &blockingStream{
+		sent:    make(chan *proto.Response),
+		release: make(chan struct{}),
+	}
+	done := make(chan error, 1)
+	o := newOutbox("test")
+	o.open(stream, done)
+	defer o.close(nil)
+
+	o.send(damageResponse(9))
+	<-stream.sent
+	// 8と7でキューが一杯になり、6はまとめられる
+	o.send(damageResponse(8))
+	o.send(damageResponse(7))
+	o.send(damageResponse(6))
+	stream.release <- struct{}{}
+	<-stream.sent
+	stream.release <- struct{}{}
+	<-stream.sent
+	// まとめた6より後の回復は6の後に届かなければならない
+	o.send(healResponse(10))
+	stream.release <- struct{}{}
+
+	events := []*proto.Response{}
+	for len(events) < 2 {
+		select {
+		case res := <-stream.sent:
+			events = append(events, res)
+			stream.release <- struct{}{}
+		case err := <-done:
+			t.Fatalf("disconnected: %v", err)
+		case <-time.After(time.Second):
+			t.Fatalf("got %v events, want 2", len(events))
+		}
+	}
+
+	if events[0].GetDamage().GetHealth() != 6 {
+		t.Fatalf("first event = %v, want damage to 6", events[0])
+	}
+	if events[1].GetHeal().GetHealth() != 10 {
+		t.Fatalf("second event = %v, want heal to 10", events[1])
+	}
+}
+
+func TestOutboxCoalesceDisconnectsOnOverflow(t *testing.T) {
+	defer func(policy SlowConsumerPolicy, size int) {
+		SlowConsumer = policy
+		QueueSize = size
+	}(SlowConsumer, QueueSize)
+	SlowConsumer = CoalescePolicy
+	QueueSize = 1
+
+	stream := &blockingStream{
+		sent:    make(chan *proto.Response),
+		release: make(chan struct{}),
+	}
+	done := make(chan error, 1)
+	o := newOutbox("test")
+	o.open(stream, done)
+	defer func() {
+		o.close(nil)
+		close(stream.release)
+	}()
+
+	o.send(damageResponse(9))
+	<-stream.sent
+	o.send(damageResponse(8))
+	// 回復はまとめられないので、捨てずに接続を切る
+	o.send(healResponse(10))
+
+	select {
+	case err := <-done:
+		if err != ErrQueueFull {
+			t.Fatalf("disconnected with %v, want %v", err, ErrQueueFull)
+		}
+	default:
+		t.Fatal("heal was dropped without disconnecting")
+	}
+}
+
+func TestOutboxCloseSendsLastEvent(t *testing.T) {
+	stream := &blockingStream{
+		sent:    make(chan *proto.Response, 1),
+		release: make(chan struct{}),
+	}
+	close(stream.release)
+	o := newOutbox("test")
+	o.open(stream, make(chan error, 1))
+
+	last := &proto.Response{
+		Event: &proto.Response_Error{
+			Error: protoError(ErrTimedOut),
+		},
+	}
+	select {
+	case <-o.close(last):
+	case <-time.After(time.Second):
+		t.Fatal("outbox did not stop")
+	}
+
+	select {
+	case res := <-stream.sent:
+		if res.GetError().GetCode() != proto.ErrorCode_TIMED_OUT {
+			t.Fatalf("last event = %v, want %v", res, proto.ErrorCode_TIMED_OUT)
+		}
+	default:
+		t.Fatal("last event was not sent")
+	}
+}
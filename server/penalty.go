@@ -0,0 +1,69 @@
+package server
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 入力ミスのペナルティ
+type Penalty int
+
+const (
+	// ペナルティなし
+	NoPenalty Penalty = iota
+	// 自分に1ダメージ
+	SelfDamagePenalty
+	// 一定時間入力を受け付けない
+	LockoutPenalty
+	// 別のお題に変わる
+	SkipPenalty
+)
+
+var PenaltyMode = NoPenalty
+
+// LockoutPenaltyで入力を受け付けない時間
+var lockoutDuration = 2 * time.Second
+
+func (p Penalty) String() string {
+	switch p {
+	case NoPenalty:
+		return "none"
+	case SelfDamagePenalty:
+		return "self"
+	case LockoutPenalty:
+		return "lockout"
+	case SkipPenalty:
+		return "skip"
+	}
+	return "unknown"
+}
+
+func ParsePenalty(s string) (Penalty, error) {
+	switch s {
+	case "none":
+		return NoPenalty, nil
+	case "self":
+		return SelfDamagePenalty, nil
+	case "lockout":
+		return LockoutPenalty, nil
+	case "skip":
+		return SkipPenalty, nil
+	}
+	return NoPenalty, fmt.Errorf("unknown penalty %v", s)
+}
+
+// 入力ミスしたプレイヤーにペナルティを与える
+func (g *Game) punish(id uuid.UUID) {
+	player := g.PlayerInfo[id]
+	switch PenaltyMode {
+	case SelfDamagePenalty:
+		g.DamagePlayer(uuid.Nil, id.String(), 1)
+	case LockoutPenalty:
+		player.LockedUntil = time.Now().Add(lockoutDuration)
+	case SkipPenalty:
+		g.Problem[id].Next()
+		g.Question(id)
+	}
+}
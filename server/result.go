@@ -0,0 +1,66 @@
+package server
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// WPMの計算で1単語とみなす打鍵数
+const keystrokesPerWord = 5
+
+// プレイヤーの現時点での成績
+func (g *Game) result(id uuid.UUID) PlayerResult {
+	player := g.PlayerInfo[id]
+	survived := time.Since(g.startedAt)
+	return PlayerResult{
+		ID:          id.String(),
+		Name:        player.Name,
+		Hit:         player.Hit,
+		Miss:        player.Miss,
+		DamageDealt: player.DamageDealt,
+		DamageTaken: player.DamageTaken,
+		WPM:         wpm(player.Keystrokes, survived),
+		Survived:    survived,
+	}
+}
+
+// 脱落したプレイヤーの成績を記録する
+// 脱落後は成績が変わらないため、この時点の成績が最終結果になる
+func (g *Game) eliminate(id uuid.UUID) {
+	g.eliminated = append(g.eliminated, g.result(id))
+}
+
+// 順位順の成績
+// 生き残ったプレイヤーが体力の多い順に上位となり、その後に脱落の遅い順に続く
+func (g *Game) results() []PlayerResult {
+	survivors := []uuid.UUID{}
+	for _, id := range g.PlayerID {
+		if g.PlayerInfo[id].Health > 0 {
+			survivors = append(survivors, id)
+		}
+	}
+	sort.SliceStable(survivors, func(i, j int) bool {
+		return g.PlayerInfo[survivors[i]].Health > g.PlayerInfo[survivors[j]].Health
+	})
+
+	results := []PlayerResult{}
+	for _, id := range survivors {
+		results = append(results, g.result(id))
+	}
+	for i := len(g.eliminated) - 1; i >= 0; i-- {
+		results = append(results, g.eliminated[i])
+	}
+	for i := range results {
+		results[i].Rank = i + 1
+	}
+	return results
+}
+
+func wpm(keystrokes int, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+	return float64(keystrokes) / keystrokesPerWord / elapsed.Minutes()
+}
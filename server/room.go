@@ -0,0 +1,931 @@
+package server
+
+import (
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoRyuuuuu/typex/proto"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// メッセージを送らないプレイヤーを切断するまでの時間
+var clientTimeout = 15 * time.Minute
+
+// 切断したプレイヤーの再接続を待つ時間。0なら待たずに退出させる
+var ReconnectGrace = 30 * time.Second
+
+// 参加してから最初にストリームを接続するまで待つ時間
+var attachTimeout = 30 * time.Second
+
+// 1クライアントが1秒間に送れるリクエスト数。0なら制限しない
+var RateLimit = 50
+
+type client struct {
+	streamServer proto.Game_StreamServer
+	done         chan error
+	lastMessage  time.Time
+	id           uuid.UUID
+	name         string
+	// ストリームを接続するたびに増える。古い切断処理を無視するために使う
+	session int
+	// 観戦者はゲームに参加せずEventだけを受け取る
+	spectator bool
+	// 送信キュー。ストリームを接続している間だけ送信する
+	outbox *outbox
+	// ストリームを接続しなかった場合に退出させるタイマー
+	expiry *time.Timer
+	// リクエスト数を数え始めた時刻と、それからのリクエスト数
+	window   time.Time
+	requests int
+}
+
+// 1つのGameとその参加者を管理する部屋
+type Room struct {
+	ID      string
+	Name    string
+	clients map[uuid.UUID]*client
+	mu      sync.RWMutex
+	game    *Game
+	quit    chan struct{}
+	// 最後のクライアントがいなくなった時刻
+	emptySince time.Time
+}
+
+func NewRoom(id string, name string, capacity int, dataset string, teams int) *Room {
+	room := &Room{
+		ID:      id,
+		Name:    name,
+		clients: make(map[uuid.UUID]*client),
+		game:    NewGame(capacity, dataset, teams),
+		quit:    make(chan struct{}),
+
+		emptySince: time.Now(),
+	}
+	room.game.Start()
+	go room.watchEvent()
+	go room.watchTimeout()
+	go room.watchMetrics()
+	return room
+}
+
+func (r *Room) close() {
+	close(r.quit)
+	r.game.Stop()
+}
+
+func (r *Room) info() *proto.Room {
+	player := 0
+	spectator := 0
+	r.mu.RLock()
+	for _, clt := range r.clients {
+		if clt.spectator {
+			spectator++
+		} else {
+			player++
+		}
+	}
+	r.mu.RUnlock()
+	return &proto.Room{
+		Id:        r.ID,
+		Name:      r.Name,
+		Player:    int64(player),
+		Capacity:  int64(r.game.Capacity),
+		Started:   r.game.CurrentState() != Waiting,
+		Dataset:   r.game.Dataset,
+		Spectator: int64(spectator),
+		Teams:     int64(r.game.Teams),
+	}
+}
+
+func (r *Room) client(id uuid.UUID) (*client, bool) {
+	r.mu.RLock()
+	clt, ok := r.clients[id]
+	r.mu.RUnlock()
+	return clt, ok
+}
+
+// ストリームを接続しているクライアント
+func (r *Room) connectedClient(id uuid.UUID) (*client, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	clt, ok := r.clients[id]
+	if !ok || clt.streamServer == nil {
+		return nil, false
+	}
+	return clt, true
+}
+
+func (r *Room) empty() bool {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return len(r.clients) == 0
+}
+
+// 誰もいなくなってからの時間。誰かいる場合は0
+func (r *Room) idle() time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	if len(r.clients) > 0 {
+		return 0
+	}
+	return time.Since(r.emptySince)
+}
+
+func (r *Room) removeClient(id uuid.UUID) {
+	r.mu.Lock()
+	clt, ok := r.clients[id]
+	delete(r.clients, id)
+	if ok && len(r.clients) == 0 {
+		r.emptySince = time.Now()
+	}
+	r.mu.Unlock()
+	if ok && !clt.spectator {
+		r.game.Leave(id)
+	}
+}
+
+// 部屋にプレイヤーを追加する
+// teamが0の場合はチームを自動で割り当てる
+func (r *Room) join(name string, team int) (*proto.ConnectResponse, error) {
+	id := uuid.New()
+
+	// プレイヤー情報をゲームサーバに登録
+	joined, err := r.game.Join(id, name, team)
+	// 開始済みのゲームには観戦者として入れる
+	if err == ErrGameStarted && LateJoin == SpectateLateJoin {
+		return r.spectate(name), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	log.Printf("join room [Room: %v, Name: %v, Team: %v, Late: %v]", r.ID, name, joined.team, joined.late)
+
+	// すでに参加しているプレイヤーの情報を追加
+	players := protoPlayers(joined.players)
+
+	player := &proto.Player{
+		Id:     id.String(),
+		Name:   name,
+		Health: int64(joined.health),
+		Team:   int64(joined.team),
+	}
+	players = append(players, player)
+
+	r.mu.Lock()
+	r.clients[id] = &client{
+		id:          id,
+		lastMessage: time.Now(),
+		name:        name,
+		outbox:      newOutbox(name),
+	}
+	log.Printf("[Room: %v, Player: %v]", r.ID, len(r.clients))
+	r.mu.Unlock()
+
+	return &proto.ConnectResponse{
+		Id:              id.String(),
+		Player:          players,
+		ProtocolVersion: proto.ProtocolVersion,
+		ServerVersion:   proto.Version,
+		Settings:        r.settings(),
+	}, nil
+}
+
+// 観戦者として部屋に追加する
+func (r *Room) spectate(name string) *proto.ConnectResponse {
+	id := uuid.New()
+
+	players := protoPlayers(r.game.Players())
+
+	r.mu.Lock()
+	r.clients[id] = &client{
+		id:          id,
+		lastMessage: time.Now(),
+		name:        name,
+		spectator:   true,
+		outbox:      newOutbox(name),
+	}
+	r.mu.Unlock()
+	log.Printf("spectate room [Room: %v, Name: %v]", r.ID, name)
+
+	return &proto.ConnectResponse{
+		Id:              id.String(),
+		Player:          players,
+		Spectator:       true,
+		ProtocolVersion: proto.ProtocolVersion,
+		ServerVersion:   proto.Version,
+		Settings:        r.settings(),
+	}
+}
+
+// クライアントに知らせる部屋のゲーム設定
+func (r *Room) settings() *proto.Settings {
+	return &proto.Settings{
+		InitialHealth: int64(InitialHealth),
+		DamageModel:   DamageMode.String(),
+		TimeLimit:     TimeLimit.Milliseconds(),
+		Dataset:       r.game.Dataset,
+	}
+}
+
+// ストリームをクライアントに紐付ける
+// 観戦者、途中参加したプレイヤーと2回目以降の接続の場合は現在の状態を送る
+func (r *Room) attach(clt *client, srv proto.Game_StreamServer) (chan error, error) {
+	r.mu.Lock()
+	if clt.streamServer != nil {
+		r.mu.Unlock()
+		return nil, status.Error(codes.AlreadyExists, "stream already active")
+	}
+	clt.streamServer = srv
+	if clt.expiry != nil {
+		clt.expiry.Stop()
+		clt.expiry = nil
+	}
+	clt.done = make(chan error, 1)
+	clt.lastMessage = time.Now()
+	clt.session++
+	clt.outbox.open(srv, clt.done)
+	done := clt.done
+	resumed := clt.session > 1
+	r.mu.Unlock()
+
+	if resumed {
+		log.Printf("resume stream [Room: %v, Name: %v]", r.ID, clt.name)
+	}
+	// 接続する前に始まったゲームのStartやお題は届いていないので、現在の状態を送る
+	if resumed || clt.spectator || r.game.CurrentState() != Waiting {
+		r.game.Snapshot(clt.id, clt.spectator)
+	}
+	return done, nil
+}
+
+// ストリームを切り離し、そのセッション番号を返す
+// lastがあれば最後に送り、送信が終わると閉じるチャネルも返す
+func (r *Room) detach(clt *client, last *proto.Response) (int, <-chan struct{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	clt.streamServer = nil
+	finished := clt.outbox.close(last)
+	return clt.session, finished
+}
+
+// 退出させるタイマーを設定する。古いタイマーは止める
+func (r *Room) setExpiry(clt *client, timer *time.Timer) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if clt.expiry != nil {
+		clt.expiry.Stop()
+	}
+	clt.expiry = timer
+}
+
+// 猶予時間内に再接続しなかったプレイヤーを退出させる
+// 退出させた場合はtrueを返す
+func (r *Room) expire(clt *client, session int) bool {
+	r.mu.RLock()
+	reconnected := clt.session != session || clt.streamServer != nil
+	r.mu.RUnlock()
+	if reconnected {
+		return false
+	}
+
+	log.Printf("%s - removing client", clt.id)
+	r.removeClient(clt.id)
+	return true
+}
+
+// 1秒間のリクエスト数がRateLimit以下ならtrueを返す
+// 制限を超えた最初のリクエストでは通知するためにnotifyをtrueにする
+func (r *Room) allow(clt *client) (allowed bool, notify bool) {
+	if RateLimit <= 0 {
+		return true, false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	now := time.Now()
+	if now.Sub(clt.window) >= time.Second {
+		clt.window = now
+		clt.requests = 0
+	}
+	clt.requests++
+	if clt.requests <= RateLimit {
+		return true, false
+	}
+	return false, clt.requests == RateLimit+1
+}
+
+// 最後にメッセージを受信した時刻を更新する
+func (r *Room) touch(clt *client) {
+	r.mu.Lock()
+	clt.lastMessage = time.Now()
+	r.mu.Unlock()
+}
+
+func (r *Room) handleAttackRequest(req *proto.Request, clt *client) {
+	r.game.ActionChannel <- AttackAction{
+		ID:     clt.id,
+		Text:   req.GetAttack().GetText(),
+		Target: req.GetAttack().GetTargetId(),
+		Mode:   TargetMode(req.GetAttack().GetMode()),
+	}
+}
+
+func (r *Room) handleAimRequest(req *proto.Request, clt *client) {
+	r.game.ActionChannel <- AimAction{
+		ID:     clt.id,
+		Target: req.GetAim().GetTargetId(),
+		Mode:   TargetMode(req.GetAim().GetMode()),
+	}
+}
+
+func (r *Room) handleProgressRequest(req *proto.Request, clt *client) {
+	r.game.ActionChannel <- ProgressAction{
+		ID:   clt.id,
+		Text: req.GetProgress().GetText(),
+	}
+}
+
+func (r *Room) handleRematchRequest(clt *client) {
+	r.game.ActionChannel <- RematchAction{
+		ID: clt.id,
+	}
+}
+
+func protoPlayers(players []Player) []*proto.Player {
+	result := []*proto.Player{}
+	for _, player := range players {
+		result = append(result, &proto.Player{
+			Id:     player.ID,
+			Name:   player.Name,
+			Health: int64(player.Health),
+			Bot:    player.Bot,
+			Team:   int64(player.Team),
+			Shield: int64(player.Shield),
+			Combo:  int64(player.Combo),
+			Target: player.Target,
+		})
+	}
+	return result
+}
+
+func protoClock(event ClockEvent) *proto.Clock {
+	return &proto.Clock{
+		Remaining:   event.Remaining.Milliseconds(),
+		SuddenDeath: event.SuddenDeath,
+		Multiplier:  int64(event.Multiplier),
+	}
+}
+
+func protoQuestion(event QuestionEvent) *proto.Question {
+	return &proto.Question{
+		Text:    event.Text,
+		Reading: event.Reading,
+		Damage:  int64(event.Damage),
+		Effect:  proto.Effect(event.Effect),
+	}
+}
+
+// backendから通知される変更の処理
+func (r *Room) watchEvent() {
+	for {
+		var event Event
+		select {
+		case event = <-r.game.EventChannel:
+		case <-r.quit:
+			return
+		}
+
+		switch event := event.(type) {
+		case StartEvent:
+			r.handleStartEvent(event)
+		case QuestionEvent:
+			r.handleQuestionEvent(event)
+		case FinishEvent:
+			r.handleFinishEvent(event)
+		case DamageEvent:
+			r.handleDamageEvent(event)
+		case HealEvent:
+			r.handleHealEvent(event)
+		case ShieldEvent:
+			r.handleShieldEvent(event)
+		case ComboEvent:
+			r.handleComboEvent(event)
+		case ClockEvent:
+			r.handleClockEvent(event)
+		case TargetChangedEvent:
+			r.handleTargetChangedEvent(event)
+		case ErrorEvent:
+			r.handleErrorEvent(event)
+		case RematchVoteEvent:
+			r.handleRematchVoteEvent(event)
+		case JoinEvent:
+			r.handleJoinEvent(event)
+		case StateEvent:
+			r.handleStateEvent(event)
+		case ProgressEvent:
+			r.handleProgressEvent(event)
+		case AttackResultEvent:
+			r.handleAttackResultEvent(event)
+		case EliminatedEvent:
+			r.handleEliminatedEvent(event)
+		case LeaveEvent:
+			r.handleLeaveEvent(event)
+		case SnapshotEvent:
+			r.handleSnapshotEvent(event)
+		}
+	}
+}
+
+func (r *Room) handleJoinEvent(event JoinEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Join{
+				Join: &proto.Join{
+					Player: &proto.Player{
+						Id:     event.ID,
+						Name:   event.Name,
+						Health: int64(event.Health),
+						Bot:    event.Bot,
+						Team:   int64(event.Team),
+					},
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleProgressEvent(event ProgressEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	// 入力している本人以外へ通知する
+	for _, clt := range r.clients {
+		if clt.streamServer == nil || clt.id.String() == event.ID {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Progress{
+				Progress: &proto.PlayerProgress{
+					Id:      event.ID,
+					Percent: int64(event.Percent),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleEliminatedEvent(event EliminatedEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Eliminated{
+				Eliminated: &proto.Eliminated{
+					Id: event.ID,
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleLeaveEvent(event LeaveEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Leave{
+				Leave: &proto.Leave{
+					Id: event.ID,
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleSnapshotEvent(event SnapshotEvent) {
+	clt, ok := r.connectedClient(event.ID)
+	if !ok {
+		return
+	}
+
+	players := protoPlayers(event.Players)
+	words := []*proto.Question{}
+	for _, word := range event.Words {
+		question := protoQuestion(word)
+		question.Id = word.ID.String()
+		words = append(words, question)
+	}
+	snapshot := &proto.Snapshot{
+		Phase:  proto.Phase(event.State),
+		Player: players,
+		Word:   words,
+	}
+	if event.Question != nil {
+		snapshot.Question = protoQuestion(*event.Question)
+	}
+	if event.Clock != nil {
+		snapshot.Clock = protoClock(*event.Clock)
+	}
+	res := &proto.Response{
+		Event: &proto.Response_Snapshot{
+			Snapshot: snapshot,
+		},
+	}
+
+	clt.outbox.send(res)
+}
+
+func (r *Room) handleStateEvent(event StateEvent) {
+	log.Printf("room %v is %v", r.ID, event.State)
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_State{
+				State: &proto.State{
+					Phase: proto.Phase(event.State),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleDamageEvent(event DamageEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Damage{
+				Damage: &proto.Damage{
+					Id:     event.ID,
+					Health: int64(event.Damage),
+					Amount: int64(event.Amount),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleHealEvent(event HealEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Heal{
+				Heal: &proto.Heal{
+					Id:     event.ID,
+					Health: int64(event.Health),
+					Amount: int64(event.Amount),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleShieldEvent(event ShieldEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Shield{
+				Shield: &proto.Shield{
+					Id:     event.ID,
+					Shield: int64(event.Shield),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleComboEvent(event ComboEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Combo{
+				Combo: &proto.Combo{
+					Id:         event.ID,
+					Combo:      int64(event.Combo),
+					Multiplier: int64(event.Multiplier),
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleClockEvent(event ClockEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_Clock{
+				Clock: protoClock(event),
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleErrorEvent(event ErrorEvent) {
+	clt, ok := r.client(event.ID)
+	if !ok {
+		return
+	}
+	r.sendError(clt, event.Err)
+}
+
+// エラーをクライアントへ通知する
+func (r *Room) sendError(clt *client, err *GameError) {
+	log.Printf("reject request from %v: %v", clt.name, err)
+	clt.outbox.send(&proto.Response{
+		Event: &proto.Response_Error{
+			Error: protoError(err),
+		},
+	})
+}
+
+func (r *Room) handleTargetChangedEvent(event TargetChangedEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		resp := &proto.Response{
+			Event: &proto.Response_TargetChanged{
+				TargetChanged: &proto.TargetChanged{
+					Id:       event.ID,
+					TargetId: event.Target,
+				},
+			},
+		}
+
+		clt.outbox.send(resp)
+	}
+}
+
+func (r *Room) handleAttackResultEvent(event AttackResultEvent) {
+	clt, ok := r.connectedClient(event.ID)
+	if !ok {
+		return
+	}
+
+	penalty := ""
+	if !event.Hit && event.Penalty != NoPenalty {
+		penalty = event.Penalty.String()
+	}
+	res := &proto.Response{
+		Event: &proto.Response_AttackResult{
+			AttackResult: &proto.AttackResult{
+				Hit:     event.Hit,
+				Penalty: penalty,
+				Lockout: event.Lockout.Milliseconds(),
+			},
+		},
+	}
+
+	clt.outbox.send(res)
+}
+
+func (r *Room) handleFinishEvent(event FinishEvent) {
+	results := []*proto.PlayerResult{}
+	for _, result := range event.Results {
+		results = append(results, &proto.PlayerResult{
+			Id:          result.ID,
+			Name:        result.Name,
+			Hit:         int64(result.Hit),
+			Miss:        int64(result.Miss),
+			Rank:        int64(result.Rank),
+			DamageDealt: int64(result.DamageDealt),
+			DamageTaken: int64(result.DamageTaken),
+			Wpm:         result.WPM,
+			Survived:    result.Survived.Milliseconds(),
+		})
+	}
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	// ゲーム終了を通知する
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		res := &proto.Response{
+			Event: &proto.Response_Finish{
+				Finish: &proto.Finish{
+					Winner: event.Winner,
+					Result: results,
+				},
+			},
+		}
+
+		clt.outbox.send(res)
+	}
+}
+
+func (r *Room) handleRematchVoteEvent(event RematchVoteEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		res := &proto.Response{
+			Event: &proto.Response_RematchVote{
+				RematchVote: &proto.RematchVote{
+					Vote:     int64(event.Vote),
+					Required: int64(event.Required),
+				},
+			},
+		}
+
+		clt.outbox.send(res)
+	}
+}
+
+func (r *Room) handleStartEvent(event StartEvent) {
+	players := protoPlayers(event.Players)
+
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	// プレイヤー全員へ通知する
+	for _, clt := range r.clients {
+		if clt.streamServer == nil {
+			continue
+		}
+
+		res := &proto.Response{
+			Event: &proto.Response_Start{
+				Start: &proto.Start{
+					Player:    players,
+					Countdown: event.Countdown.Milliseconds(),
+				},
+			},
+		}
+
+		clt.outbox.send(res)
+	}
+}
+
+func (r *Room) handleQuestionEvent(event QuestionEvent) {
+	id := event.ID
+	text := event.Text
+
+	// ボットや切断中のプレイヤーのお題も観戦者には送る
+	r.sendQuestionToSpectators(event)
+
+	clt, ok := r.connectedClient(id)
+	if !ok {
+		return
+	}
+
+	res := &proto.Response{
+		Event: &proto.Response_Question{
+			Question: protoQuestion(event),
+		},
+	}
+
+	clt.outbox.send(res)
+
+	log.Printf("send %v to %v\n", text, clt.name)
+}
+
+// 観戦者には誰のお題かを付けて送る
+func (r *Room) sendQuestionToSpectators(event QuestionEvent) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	for _, clt := range r.clients {
+		if !clt.spectator || clt.streamServer == nil {
+			continue
+		}
+
+		question := protoQuestion(event)
+		question.Id = event.ID.String()
+		res := &proto.Response{
+			Event: &proto.Response_Question{
+				Question: question,
+			},
+		}
+
+		clt.outbox.send(res)
+	}
+}
+
+func (r *Room) watchTimeout() {
+	// タイムアウトが短い場合はその半分ごとに確かめる
+	interval := 1 * time.Minute
+	if clientTimeout < 2*interval {
+		interval = clientTimeout / 2
+	}
+	timeoutTicker := time.NewTicker(interval)
+	defer timeoutTicker.Stop()
+	for {
+		r.mu.RLock()
+		for _, client := range r.clients {
+			// 切断中のプレイヤーは再接続の猶予時間で処理する
+			// 観戦者はメッセージを送らないので対象外
+			if client.streamServer == nil || client.spectator {
+				continue
+			}
+			if time.Since(client.lastMessage) > clientTimeout {
+				select {
+				case client.done <- ErrTimedOut:
+				default:
+				}
+			}
+		}
+		r.mu.RUnlock()
+
+		select {
+		case <-timeoutTicker.C:
+		case <-r.quit:
+			return
+		}
+	}
+}
+
+// 各クライアントの送信キューの状態を定期的にログに出す
+func (r *Room) watchMetrics() {
+	if MetricsInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(MetricsInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-r.quit:
+			return
+		}
+
+		r.mu.RLock()
+		for _, clt := range r.clients {
+			depth, peak, dropped := clt.outbox.stats()
+			log.Printf("queue [Room: %v, Name: %v, Depth: %v, Peak: %v, Dropped: %v]", r.ID, clt.name, depth, peak, dropped)
+		}
+		r.mu.RUnlock()
+	}
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoRyuuuuu/typex/proto"
+)
+
+// 送信したEventを捨てるストリーム
+type discardStream struct {
+	proto.Game_StreamServer
+}
+
+func (discardStream) Send(*proto.Response) error {
+	return nil
+}
+
+// 参加、攻撃、退出を同時に行っても競合しないことを-raceで確かめる
+func TestRoomConcurrentJoinAttackLeave(t *testing.T) {
+	defer func(delay, countdown time.Duration) {
+		startDelay = delay
+		countdownDuration = countdown
+	}(startDelay, countdownDuration)
+	startDelay = time.Millisecond
+	countdownDuration = time.Millisecond
+
+	room := NewRoom("test", "test", 4, DefaultDataset, 0)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			res, err := room.join(fmt.Sprintf("player%v", i), 0)
+			if err != nil {
+				// 満員か開始済み
+				return
+			}
+			id := uuid.MustParse(res.GetId())
+			clt, ok := room.client(id)
+			if !ok {
+				t.Errorf("client %v is not in the room", id)
+				return
+			}
+			if _, err := room.attach(clt, discardStream{}); err != nil {
+				t.Errorf("failed to attach: %v", err)
+				return
+			}
+
+			modes := []proto.TargetMode{proto.TargetMode_LOWEST_HEALTH, proto.TargetMode_HIGHEST_HEALTH, proto.TargetMode_ATTACKER}
+			for j := 0; j < 50; j++ {
+				room.handleAttackRequest(&proto.Request{
+					Action: &proto.Request_Attack{
+						Attack: &proto.Attack{Text: "word", Mode: modes[j%len(modes)]},
+					},
+				}, clt)
+				room.info()
+			}
+
+			room.detach(clt, nil)
+			room.removeClient(id)
+		}(i)
+	}
+	wg.Wait()
+
+	// 部屋を閉じても参加待ちのプレイヤーが止まらないこと
+	joined := make(chan struct{})
+	go func() {
+		defer close(joined)
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				room.join(fmt.Sprintf("late%v", i), 0)
+			}(i)
+		}
+		wg.Wait()
+	}()
+	room.close()
+
+	select {
+	case <-joined:
+	case <-time.After(5 * time.Second):
+		t.Fatal("join did not return after the room was closed")
+	}
+}
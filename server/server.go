@@ -3,9 +3,7 @@ package server
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log"
-	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -14,86 +12,91 @@ import (
 )
 
 var PlayerCount = 5
-var clientTimeout = 15.0
 
-type client struct {
-	streamServer proto.Game_StreamServer
-	done         chan error
-	lastMessage  time.Time
-	id           uuid.UUID
-	name         string
-}
+// デフォルトの部屋のチーム数。0なら個人戦
+var Teams = 0
+
+// 切断する理由を送り終えるまで待つ時間
+const lastEventTimeout = time.Second
 
 type GameServer struct {
 	proto.UnimplementedGameServer
-	clients map[uuid.UUID]*client
-	mu      sync.RWMutex
-	game    *Game
+	lobby *Lobby
 }
 
-func (s *GameServer) removeClient(id uuid.UUID) {
-	s.mu.Lock()
-	delete(s.clients, id)
-	s.game.PlayerCount--
-	s.mu.Unlock()
-}
-
-func NewGameServer(game *Game) *GameServer {
+func NewGameServer(lobby *Lobby) *GameServer {
 	server := &GameServer{
-		clients: make(map[uuid.UUID]*client),
-		game:    game,
+		lobby: lobby,
 	}
-	go server.watchEvent()
-	go server.watchTimeout()
 	return server
 }
 
-func (s *GameServer) getClientFromContext(ctx context.Context) (*client, error) {
+func (s *GameServer) getClientFromContext(ctx context.Context) (*Room, *client, error) {
 	headers, _ := metadata.FromIncomingContext(ctx)
 	tokenRaw := headers["authorization"]
 	if len(tokenRaw) == 0 {
-		return nil, errors.New("no token provided")
+		return nil, nil, unauthenticated("no token provided")
 	}
 	token, err := uuid.Parse(tokenRaw[0])
 	if err != nil {
-		return nil, errors.New("cannot parse token")
+		return nil, nil, unauthenticated("cannot parse token")
 	}
-	s.mu.RLock()
-	clt, ok := s.clients[token]
-	s.mu.RUnlock()
+	room, clt, ok := s.lobby.lookup(token)
 	if !ok {
-		return nil, errors.New("token no recognized")
+		return nil, nil, unauthenticated("token no recognized")
 	}
-	return clt, nil
+	return room, clt, nil
 }
 
 func (s *GameServer) Stream(srv proto.Game_StreamServer) error {
 	ctx := srv.Context()
-	clt, err := s.getClientFromContext(ctx)
+	room, clt, err := s.getClientFromContext(ctx)
 	if err != nil {
 		return err
 	}
-	if clt.streamServer != nil {
-		return errors.New("stream already active")
+	done, err := room.attach(clt, srv)
+	if err != nil {
+		return err
 	}
-	clt.streamServer = srv
-	log.Println("start new server")
+	log.Printf("start new stream [Room: %v]", room.ID)
 
 	go func() {
 		for {
 			req, err := srv.Recv()
 			if err != nil {
 				log.Printf("receive error %v", err)
-				clt.done <- errors.New("failed to receive request")
+				select {
+				case done <- ErrConnectionLost:
+				default:
+				}
 				return
 			}
 
-			log.Printf("got message [ID %v, Request %+v]", clt.id, req)
-			clt.lastMessage = time.Now()
+			// 入力途中の文字列はキー入力のたびに届くのでログに出さない
+			if req.GetProgress() == nil {
+				log.Printf("got message [ID %v, Request %+v]", clt.id, req)
+			}
+			room.touch(clt)
+			// 観戦者の操作は受け付けない
+			if clt.spectator {
+				continue
+			}
+			if allowed, notify := room.allow(clt); !allowed {
+				if notify {
+					room.sendError(clt, ErrRateLimited)
+				}
+				continue
+			}
 
 			switch req.GetAction().(type) {
 			case *proto.Request_Attack:
-				s.handleAttackRequest(req, clt)
+				room.handleAttackRequest(req, clt)
+			case *proto.Request_Rematch:
+				room.handleRematchRequest(clt)
+			case *proto.Request_Progress:
+				room.handleProgressRequest(req, clt)
+			case *proto.Request_Aim:
+				room.handleAimRequest(req, clt)
 			}
 		}
 	}()
@@ -102,209 +105,118 @@ func (s *GameServer) Stream(srv proto.Game_StreamServer) error {
 	select {
 	case <-ctx.Done():
 		doneError = ctx.Err()
-	case doneError = <-clt.done:
+	case doneError = <-done:
 	}
 
 	log.Printf("stream done with error %v", doneError)
-	log.Printf("%s - removing client", clt.id)
-	s.removeClient(clt.id)
-
-	return doneError
-}
-
-func (s *GameServer) Connect(ctx context.Context, req *proto.ConnectRequest) (*proto.ConnectResponse, error) {
-	if len(s.clients) >= PlayerCount {
-		return nil, errors.New("The server is full")
-	}
-
-	log.Printf("connect this server [Name: %v]", req.GetName())
-	s.mu.Lock()
-	id := uuid.New()
-
-	// プレイヤー情報をゲームサーバに登録
-	s.game.PlayerID = append(s.game.PlayerID, id)
-	s.game.Problem[id] = NewDatasetIterator()
-	playerInfo := &PlayerInfo{
-		Health: InitialHealth,
-		Name:   req.GetName(),
-	}
-	s.game.PlayerInfo[id] = playerInfo
-
-	players := []*proto.Player{}
-	for _, clt := range s.clients {
-		if clt.streamServer == nil {
-			continue
-		}
-
-		// すでに参加しているプレイヤーの情報を追加
-		player := &proto.Player{
-			Id:     clt.id.String(),
-			Name:   clt.name,
-			Health: InitialHealth,
-		}
-		players = append(players, player)
-
-		// 他のプレイヤーへ参加者情報を通知
-		resp := &proto.Response{
-			Event: &proto.Response_Join{
-				Join: &proto.Join{
-					Player: &proto.Player{
-						Id:     id.String(),
-						Name:   req.GetName(),
-						Health: InitialHealth,
-					},
-				},
+	// サーバが切断する場合は理由をErrorのEventで送ってからストリームを閉じる
+	var last *proto.Response
+	var gameErr *GameError
+	if errors.As(doneError, &gameErr) {
+		last = &proto.Response{
+			Event: &proto.Response_Error{
+				Error: protoError(gameErr),
 			},
 		}
-
-		if err := clt.streamServer.Send(resp); err != nil {
-			log.Printf("failed to send finish event %v: %v", clt.name, err)
-		}
+		doneError = statusError(gameErr)
 	}
-
-	player := &proto.Player{
-		Id:     id.String(),
-		Name:   req.GetName(),
-		Health: InitialHealth,
-	}
-	players = append(players, player)
-
-	s.clients[id] = &client{
-		id:          id,
-		done:        make(chan error),
-		lastMessage: time.Now(),
-		name:        req.GetName(),
+	// 猶予時間内に同じトークンで再接続すれば対戦を続けられる
+	session, finished := room.detach(clt, last)
+	if last != nil {
+		select {
+		case <-finished:
+		case <-time.After(lastEventTimeout):
+		}
 	}
+	s.expireLater(room, clt, session, ReconnectGrace)
 
-	s.game.PlayerCount++
-	log.Printf("[Player: %v]", s.game.PlayerCount)
-	s.mu.Unlock()
-
-	fmt.Println(players)
-	return &proto.ConnectResponse{
-		Id:     id.String(),
-		Player: players,
-	}, nil
-}
-
-func (s *GameServer) handleAttackRequest(req *proto.Request, clt *client) {
-	s.game.ActionChannel <- AttackAction{
-		ID:     clt.id,
-		Text:   req.GetAttack().GetText(),
-		Target: req.GetAttack().GetTargetId(),
-	}
+	return doneError
 }
 
-// backendから通知される変更の処理
-func (s *GameServer) watchEvent() {
-	for {
-		event := <-s.game.EventChannel
-		switch event := event.(type) {
-		case StartEvent:
-			s.handleStartEvent()
-		case QuestionEvent:
-			s.handleQuestionEvent(event)
-		case FinishEvent:
-			s.handleFinishEvent(event)
-		case DamageEvent:
-			s.handleDamageEvent(event)
+// dの間に同じトークンでストリームを接続しなければ退出させる
+func (s *GameServer) expireLater(room *Room, clt *client, session int, d time.Duration) {
+	timer := time.AfterFunc(d, func() {
+		if room.expire(clt, session) {
+			s.lobby.release(room)
 		}
-	}
+	})
+	room.setExpiry(clt, timer)
 }
 
-func (s *GameServer) handleDamageEvent(event DamageEvent) {
-	for _, clt := range s.clients {
-		if clt.streamServer == nil {
-			continue
-		}
-
-		resp := &proto.Response{
-			Event: &proto.Response_Damage{
-				Damage: &proto.Damage{
-					Id:     event.ID,
-					Health: int64(event.Damage),
-				},
-			},
-		}
-
-		if err := clt.streamServer.Send(resp); err != nil {
-			log.Printf("failed to send finish event %v: %v", clt.name, err)
-		}
+// 参加したままストリームを接続しないクライアントを退出させる
+func (s *GameServer) expireUnattached(room *Room, resp *proto.ConnectResponse) {
+	id, err := uuid.Parse(resp.GetId())
+	if err != nil {
+		return
+	}
+	if clt, ok := room.client(id); ok {
+		s.expireLater(room, clt, 0, attachTimeout)
 	}
 }
 
-func (s *GameServer) handleFinishEvent(event FinishEvent) {
-	// ゲーム終了を通知する
-	for _, clt := range s.clients {
-		if clt.streamServer == nil {
-			continue
-		}
-
-		res := &proto.Response{
-			Event: &proto.Response_Finish{
-				Finish: &proto.Finish{
-					Winner: event.Winner,
-				},
-			},
-		}
-
-		if err := clt.streamServer.Send(res); err != nil {
-			log.Printf("failed to send finish event %v: %v", clt.name, err)
-		}
+// デフォルトの部屋に参加する
+func (s *GameServer) Connect(ctx context.Context, req *proto.ConnectRequest) (*proto.ConnectResponse, error) {
+	log.Printf("connect this server [Name: %v, Version: %v]", req.GetName(), req.GetClient().GetVersion())
+	if err := checkClient(req.GetClient()); err != nil {
+		return nil, statusError(err)
+	}
+	room, _ := s.lobby.Room("")
+	resp, err := room.join(req.GetName(), int(req.GetTeam()))
+	if err != nil {
+		return nil, statusError(err)
 	}
+	s.expireUnattached(room, resp)
+	return resp, nil
 }
 
-func (s *GameServer) handleStartEvent() {
-	// プレイヤー全員へ通知する
-	for _, clt := range s.clients {
-		if clt.streamServer == nil {
-			continue
-		}
-
-		res := &proto.Response{
-			Event: &proto.Response_Start{},
-		}
-
-		if err := clt.streamServer.Send(res); err != nil {
-			log.Printf("failed to send start event %v: %v", clt.name, err)
-		}
+func (s *GameServer) CreateRoom(ctx context.Context, req *proto.CreateRoomRequest) (*proto.CreateRoomResponse, error) {
+	room, err := s.lobby.CreateRoom(req.GetName(), int(req.GetCapacity()), req.GetDataset(), int(req.GetTeams()))
+	if err != nil {
+		return nil, statusError(err)
 	}
+	log.Printf("create room [ID: %v, Name: %v]", room.ID, room.Name)
+	return &proto.CreateRoomResponse{
+		Room: room.info(),
+	}, nil
 }
 
-func (s *GameServer) handleQuestionEvent(event QuestionEvent) {
-	id := event.ID
-	text := event.Text
-
-	clt := s.clients[id]
-	if clt == nil {
-		return
+func (s *GameServer) ListRooms(ctx context.Context, req *proto.ListRoomsRequest) (*proto.ListRoomsResponse, error) {
+	rooms := []*proto.Room{}
+	for _, room := range s.lobby.Rooms() {
+		rooms = append(rooms, room.info())
 	}
+	return &proto.ListRoomsResponse{
+		Room: rooms,
+	}, nil
+}
 
-	res := &proto.Response{
-		Event: &proto.Response_Question{
-			Question: &proto.Question{
-				Text: text,
-			},
-		},
+// 指定した部屋を観戦する
+func (s *GameServer) Spectate(ctx context.Context, req *proto.SpectateRequest) (*proto.ConnectResponse, error) {
+	if err := checkClient(req.GetClient()); err != nil {
+		return nil, statusError(err)
 	}
-
-	if err := clt.streamServer.Send(res); err != nil {
-		log.Printf("failed to send question event to %v: %v", clt.name, err)
-		return
+	room, ok := s.lobby.Room(req.GetRoomId())
+	if !ok {
+		return nil, statusError(ErrRoomNotFound)
 	}
-
-	log.Printf("send %v to %v\n", text, clt.name)
+	resp := room.spectate(req.GetName())
+	s.expireUnattached(room, resp)
+	return resp, nil
 }
 
-func (s *GameServer) watchTimeout() {
-	timeoutTicker := time.NewTicker(1 * time.Minute)
-	for {
-		for _, client := range s.clients {
-			if time.Since(client.lastMessage).Minutes() > clientTimeout {
-				client.done <- errors.New("you have been timed out")
-				return
-			}
-		}
-		<-timeoutTicker.C
+// 指定した部屋に参加する
+func (s *GameServer) JoinRoom(ctx context.Context, req *proto.JoinRoomRequest) (*proto.ConnectResponse, error) {
+	if err := checkClient(req.GetClient()); err != nil {
+		return nil, statusError(err)
+	}
+	room, ok := s.lobby.Room(req.GetRoomId())
+	if !ok {
+		return nil, statusError(ErrRoomNotFound)
+	}
+	resp, err := room.join(req.GetName(), int(req.GetTeam()))
+	if err != nil {
+		return nil, statusError(err)
 	}
+	s.expireUnattached(room, resp)
+	return resp, nil
 }
@@ -0,0 +1,59 @@
+package server
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/yoRyuuuuu/typex/proto"
+)
+
+func testClientInfo() *proto.ClientInfo {
+	return &proto.ClientInfo{
+		ProtocolVersion: proto.ProtocolVersion,
+		Capability:      requiredCapabilities(),
+	}
+}
+
+func TestUnattachedClientExpires(t *testing.T) {
+	defer func(timeout time.Duration) {
+		attachTimeout = timeout
+	}(attachTimeout)
+	attachTimeout = 50 * time.Millisecond
+
+	lobby, err := NewLobby()
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewGameServer(lobby)
+	ctx := context.Background()
+
+	// ストリームを接続しないプレイヤーと、接続したプレイヤー
+	idle, err := s.Connect(ctx, &proto.ConnectRequest{Name: "idle", Client: testClientInfo()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	active, err := s.Connect(ctx, &proto.ConnectRequest{Name: "active", Client: testClientInfo()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	room, _ := lobby.Room("")
+	clt, ok := room.client(uuid.MustParse(active.GetId()))
+	if !ok {
+		t.Fatal("active client is not in the room")
+	}
+	if _, err := room.attach(clt, discardStream{}); err != nil {
+		t.Fatal(err)
+	}
+	defer room.detach(clt, nil)
+
+	time.Sleep(5 * attachTimeout)
+
+	if _, ok := room.client(uuid.MustParse(idle.GetId())); ok {
+		t.Fatal("client without a stream was not removed")
+	}
+	if _, ok := room.client(uuid.MustParse(active.GetId())); !ok {
+		t.Fatal("client with a stream was removed")
+	}
+}
@@ -0,0 +1,42 @@
+package server
+
+// ゲームの状態
+// Waiting -> Countdown -> Playing -> Finished の順に遷移し、
+// 再戦時は Finished -> Countdown に戻る
+type State int
+
+const (
+	// プレイヤーの参加待ち
+	Waiting State = iota
+	// 開始前のカウントダウン中
+	Countdown
+	// 対戦中
+	Playing
+	// 勝者が決まり再戦の投票待ち
+	Finished
+)
+
+func (s State) String() string {
+	switch s {
+	case Waiting:
+		return "Waiting"
+	case Countdown:
+		return "Countdown"
+	case Playing:
+		return "Playing"
+	case Finished:
+		return "Finished"
+	}
+	return "Unknown"
+}
+
+// 状態を遷移させて通知する
+func (g *Game) setState(state State) {
+	if g.State == state {
+		return
+	}
+	g.State = state
+	g.EventChannel <- StateEvent{
+		State: state,
+	}
+}
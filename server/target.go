@@ -0,0 +1,192 @@
+package server
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/google/uuid"
+)
+
+// 攻撃対象の選び方。DirectTarget以外は攻撃のたびにサーバが選ぶ
+type TargetMode int
+
+const (
+	// クライアントが指定したプレイヤーを狙う
+	DirectTarget TargetMode = iota
+	// ランダムに選ぶ
+	RandomTarget
+	// 体力が最も少ないプレイヤーを狙う
+	LowestHealthTarget
+	// 体力が最も多いプレイヤーを狙う
+	HighestHealthTarget
+	// 最後に自分にダメージを与えたプレイヤーを狙う
+	RevengeTarget
+	// 自分を狙っているプレイヤーを狙う
+	AttackerTarget
+)
+
+func (m TargetMode) String() string {
+	switch m {
+	case DirectTarget:
+		return "direct"
+	case RandomTarget:
+		return "random"
+	case LowestHealthTarget:
+		return "lowest"
+	case HighestHealthTarget:
+		return "highest"
+	case RevengeTarget:
+		return "revenge"
+	case AttackerTarget:
+		return "attacker"
+	}
+	return "unknown"
+}
+
+func ParseTargetMode(s string) (TargetMode, error) {
+	switch s {
+	case "direct":
+		return DirectTarget, nil
+	case "random":
+		return RandomTarget, nil
+	case "lowest":
+		return LowestHealthTarget, nil
+	case "highest":
+		return HighestHealthTarget, nil
+	case "revenge":
+		return RevengeTarget, nil
+	case "attacker":
+		return AttackerTarget, nil
+	}
+	return DirectTarget, fmt.Errorf("unknown target mode %v", s)
+}
+
+// idのプレイヤーが攻撃できる相手。生きている自分以外の敵
+func (g *Game) targets(id uuid.UUID) []uuid.UUID {
+	player := g.PlayerInfo[id]
+	targets := []uuid.UUID{}
+	for _, targetID := range g.PlayerID {
+		target := g.PlayerInfo[targetID]
+		if targetID != id && target.Health > 0 && !g.isAlly(player, target) {
+			targets = append(targets, targetID)
+		}
+	}
+	return targets
+}
+
+// 攻撃時に攻撃対象を決める。攻撃できる相手がいなければfalseを返す
+// 復讐と反撃の対象がいない場合はランダムに選ぶ
+func (g *Game) resolveTarget(id uuid.UUID, mode TargetMode, requested string) (uuid.UUID, bool) {
+	targets := g.targets(id)
+	if len(targets) == 0 {
+		return uuid.Nil, false
+	}
+
+	switch mode {
+	case DirectTarget:
+		targetID, err := uuid.Parse(requested)
+		if err != nil {
+			return uuid.Nil, false
+		}
+		for _, target := range targets {
+			if target == targetID {
+				return targetID, true
+			}
+		}
+		return uuid.Nil, false
+	case LowestHealthTarget:
+		targets = g.extremeHealth(targets, -1)
+	case HighestHealthTarget:
+		targets = g.extremeHealth(targets, 1)
+	case RevengeTarget:
+		attacker := g.PlayerInfo[id].LastAttacker
+		for _, target := range targets {
+			if target == attacker {
+				return attacker, true
+			}
+		}
+	case AttackerTarget:
+		attackers := []uuid.UUID{}
+		for _, target := range targets {
+			if g.PlayerInfo[target].Target == id {
+				attackers = append(attackers, target)
+			}
+		}
+		if len(attackers) > 0 {
+			targets = attackers
+		}
+	}
+	return targets[rand.Intn(len(targets))], true
+}
+
+// 体力が最も少ない(sign=-1)または最も多い(sign=1)プレイヤー。同じ体力なら全員
+func (g *Game) extremeHealth(targets []uuid.UUID, sign int) []uuid.UUID {
+	result := []uuid.UUID{}
+	best := 0
+	for _, target := range targets {
+		health := g.PlayerInfo[target].Health * sign
+		switch {
+		case len(result) == 0 || health > best:
+			result = []uuid.UUID{target}
+			best = health
+		case health == best:
+			result = append(result, target)
+		}
+	}
+	return result
+}
+
+// 攻撃対象を記録し、変わった場合は通知する
+func (g *Game) setTarget(id uuid.UUID, target uuid.UUID) {
+	player := g.PlayerInfo[id]
+	if player.Target == target {
+		return
+	}
+	player.Target = target
+	event := TargetChangedEvent{ID: id.String()}
+	if target != uuid.Nil {
+		event.Target = target.String()
+	}
+	g.EventChannel <- event
+}
+
+// 脱落または退出したプレイヤーを狙っていた攻撃対象を外す
+func (g *Game) clearTargets(id uuid.UUID) {
+	for _, playerID := range g.PlayerID {
+		if g.PlayerInfo[playerID].Target == id {
+			g.setTarget(playerID, uuid.Nil)
+		}
+	}
+}
+
+func (action AimAction) Perform(game *Game) {
+	// 開始前のカウントダウン中から受け付ける
+	if game.State != Countdown && game.State != Playing {
+		return
+	}
+	player, ok := game.PlayerInfo[action.ID]
+	if !ok || player.Health <= 0 {
+		return
+	}
+
+	// サーバが選ぶ場合は攻撃するまで攻撃対象は決まらない
+	if action.Mode != DirectTarget {
+		game.setTarget(action.ID, uuid.Nil)
+		return
+	}
+	target, ok := game.resolveTarget(action.ID, DirectTarget, action.Target)
+	if !ok {
+		game.reject(action.ID, ErrInvalidTarget)
+		return
+	}
+	game.setTarget(action.ID, target)
+}
+
+// 攻撃対象のID。決まっていなければ空
+func (g *Game) target(id uuid.UUID) string {
+	target := g.PlayerInfo[id].Target
+	if target == uuid.Nil {
+		return ""
+	}
+	return target.String()
+}
@@ -0,0 +1,182 @@
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// 指定した体力とチームのプレイヤーがいるゲーム。Eventは発行しない
+func targetGame(teams int, players ...PlayerInfo) (*Game, []uuid.UUID) {
+	game := NewGame(len(players), DefaultDataset, teams)
+	ids := []uuid.UUID{}
+	for i := range players {
+		id := uuid.New()
+		player := players[i]
+		game.PlayerID = append(game.PlayerID, id)
+		game.PlayerInfo[id] = &player
+		ids = append(ids, id)
+	}
+	return game, ids
+}
+
+func TestResolveTargetDirect(t *testing.T) {
+	game, ids := targetGame(2,
+		PlayerInfo{Name: "me", Health: 10, Team: 1},
+		PlayerInfo{Name: "ally", Health: 10, Team: 1},
+		PlayerInfo{Name: "enemy", Health: 10, Team: 2},
+		PlayerInfo{Name: "eliminated", Health: 0, Team: 2},
+	)
+
+	tests := []struct {
+		name   string
+		target string
+		want   uuid.UUID
+		ok     bool
+	}{
+		{"enemy", ids[2].String(), ids[2], true},
+		{"self", ids[0].String(), uuid.Nil, false},
+		{"ally", ids[1].String(), uuid.Nil, false},
+		{"eliminated", ids[3].String(), uuid.Nil, false},
+		{"unknown", uuid.New().String(), uuid.Nil, false},
+		{"invalid", "not-a-uuid", uuid.Nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := game.resolveTarget(ids[0], DirectTarget, tt.target)
+			if got != tt.want || ok != tt.ok {
+				t.Fatalf("resolveTarget(%v) = %v, %v, want %v, %v", tt.target, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestResolveTargetHealth(t *testing.T) {
+	game, ids := targetGame(0,
+		PlayerInfo{Name: "me", Health: 1},
+		PlayerInfo{Name: "weak", Health: 3},
+		PlayerInfo{Name: "middle", Health: 7},
+		PlayerInfo{Name: "strong", Health: 12},
+		PlayerInfo{Name: "eliminated", Health: 0},
+	)
+
+	// 自分の体力が最も少なくても自分は選ばない
+	if got, ok := game.resolveTarget(ids[0], LowestHealthTarget, ""); !ok || got != ids[1] {
+		t.Fatalf("lowest = %v, %v, want %v", got, ok, ids[1])
+	}
+	if got, ok := game.resolveTarget(ids[0], HighestHealthTarget, ""); !ok || got != ids[3] {
+		t.Fatalf("highest = %v, %v, want %v", got, ok, ids[3])
+	}
+}
+
+func TestResolveTargetTie(t *testing.T) {
+	game, ids := targetGame(0,
+		PlayerInfo{Name: "me", Health: 10},
+		PlayerInfo{Name: "a", Health: 5},
+		PlayerInfo{Name: "b", Health: 5},
+		PlayerInfo{Name: "c", Health: 8},
+		PlayerInfo{Name: "d", Health: 8},
+	)
+
+	tests := []struct {
+		name string
+		mode TargetMode
+		want []uuid.UUID
+	}{
+		{"lowest", LowestHealthTarget, ids[1:3]},
+		{"highest", HighestHealthTarget, ids[3:5]},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 同じ体力のプレイヤーからランダムに選ぶので、何度か選べばどちらも選ばれる
+			seen := make(map[uuid.UUID]bool)
+			for i := 0; i < 200; i++ {
+				got, ok := game.resolveTarget(ids[0], tt.mode, "")
+				if !ok || (got != tt.want[0] && got != tt.want[1]) {
+					t.Fatalf("resolveTarget = %v, %v, want one of %v", got, ok, tt.want)
+				}
+				seen[got] = true
+			}
+			if len(seen) != 2 {
+				t.Fatalf("picked only %v of the tied players %v", seen, tt.want)
+			}
+		})
+	}
+}
+
+func TestResolveTargetNoEnemy(t *testing.T) {
+	game, ids := targetGame(2,
+		PlayerInfo{Name: "me", Health: 10, Team: 1},
+		PlayerInfo{Name: "ally", Health: 10, Team: 1},
+		PlayerInfo{Name: "eliminated", Health: 0, Team: 2},
+	)
+
+	modes := []TargetMode{DirectTarget, RandomTarget, LowestHealthTarget, HighestHealthTarget, RevengeTarget, AttackerTarget}
+	for _, mode := range modes {
+		if got, ok := game.resolveTarget(ids[0], mode, ids[1].String()); ok {
+			t.Fatalf("%v: resolveTarget = %v, want no target", mode, got)
+		}
+	}
+}
+
+func TestResolveTargetRevenge(t *testing.T) {
+	game, ids := targetGame(0,
+		PlayerInfo{Name: "me", Health: 10},
+		PlayerInfo{Name: "attacker", Health: 10},
+		PlayerInfo{Name: "other", Health: 10},
+	)
+	game.PlayerInfo[ids[0]].LastAttacker = ids[1]
+	game.PlayerInfo[ids[2]].Target = ids[0]
+
+	if got, ok := game.resolveTarget(ids[0], RevengeTarget, ""); !ok || got != ids[1] {
+		t.Fatalf("revenge = %v, %v, want %v", got, ok, ids[1])
+	}
+	if got, ok := game.resolveTarget(ids[0], AttackerTarget, ""); !ok || got != ids[2] {
+		t.Fatalf("attacker = %v, %v, want %v", got, ok, ids[2])
+	}
+}
+
+func TestAttackKeepsTargetUntilHit(t *testing.T) {
+	game, ids := targetGame(0,
+		PlayerInfo{Name: "me", Health: 10},
+		PlayerInfo{Name: "enemy", Health: 10},
+	)
+	game.State = Playing
+	for _, id := range ids {
+		game.Problem[id] = NewDatasetIterator(game.Dataset)
+	}
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		for {
+			select {
+			case <-game.EventChannel:
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	me := game.PlayerInfo[ids[0]]
+	attack := AttackAction{ID: ids[0], Target: ids[1].String(), Mode: DirectTarget}
+
+	attack.Text = game.Problem[ids[0]].Peek().Text + "x"
+	attack.Perform(game)
+	if me.Target != uuid.Nil {
+		t.Fatalf("target = %v after a wrong word, want none", me.Target)
+	}
+
+	me.LockedUntil = time.Now().Add(time.Minute)
+	attack.Text = game.Problem[ids[0]].Peek().Text
+	attack.Perform(game)
+	if me.Target != uuid.Nil {
+		t.Fatalf("target = %v during lockout, want none", me.Target)
+	}
+
+	me.LockedUntil = time.Time{}
+	attack.Perform(game)
+	if me.Target != ids[1] {
+		t.Fatalf("target = %v after a hit, want %v", me.Target, ids[1])
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"fmt"
+)
+
+// チーム数は0(個人戦)または2以上で、各チームに1人以上入れる数
+func validateTeams(teams int, capacity int) error {
+	if teams == 0 {
+		return nil
+	}
+	if teams < 2 {
+		return fmt.Errorf("invalid number of teams %v", teams)
+	}
+	if teams > capacity {
+		return fmt.Errorf("%v teams need at least %v players", teams, teams)
+	}
+	return nil
+}
+
+// 1チームの最大人数
+func (g *Game) teamSize() int {
+	return (g.Capacity + g.Teams - 1) / g.Teams
+}
+
+// 参加するチームを決める
+// requestedが0の場合は人数が最も少ないチームに割り当てる
+func (g *Game) assignTeam(requested int) (int, error) {
+	if g.Teams == 0 {
+		return 0, nil
+	}
+
+	members := make([]int, g.Teams+1)
+	for _, player := range g.PlayerInfo {
+		members[player.Team]++
+	}
+
+	if requested != 0 {
+		if requested < 0 || requested > g.Teams {
+			return 0, ErrTeamNotFound
+		}
+		if members[requested] >= g.teamSize() {
+			return 0, ErrTeamFull
+		}
+		return requested, nil
+	}
+
+	team := 1
+	for t := 2; t <= g.Teams; t++ {
+		if members[t] < members[team] {
+			team = t
+		}
+	}
+	return team, nil
+}
+
+// 同じチームの味方か。個人戦では自分以外は全員敵
+func (g *Game) isAlly(a *PlayerInfo, b *PlayerInfo) bool {
+	return g.Teams > 0 && a.Team == b.Team
+}
+
+// 体力が1以上のプレイヤーが残っている陣営の数と、最後に見つけた陣営の名前
+// 個人戦ではプレイヤー、チーム戦ではチームを1つの陣営とする
+func (g *Game) survivors() (int, string) {
+	count := 0
+	winner := ""
+	teams := make(map[int]bool)
+	for _, id := range g.PlayerID {
+		player := g.PlayerInfo[id]
+		if player.Health < 1 {
+			continue
+		}
+		if g.Teams == 0 {
+			count++
+			winner = player.Name
+			continue
+		}
+		if !teams[player.Team] {
+			teams[player.Team] = true
+			count++
+			winner = fmt.Sprintf("Team %v", player.Team)
+		}
+	}
+	return count, winner
+}
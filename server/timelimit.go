@@ -0,0 +1,114 @@
+package server
+
+import (
+	"fmt"
+	"log"
+	"time"
+)
+
+// 対戦の制限時間。0なら制限なし
+var TimeLimit time.Duration = 0
+
+// サドンデスでダメージが2倍になる間隔
+var SuddenDeathInterval = 10 * time.Second
+
+// サドンデスで倍率を上げる回数の上限
+const maxSuddenDeath = 10
+
+// 制限時間が過ぎたときに発行されるAction
+type timeUpAction struct {
+	round int
+}
+
+// サドンデスでダメージを2倍にするAction
+type suddenDeathAction struct {
+	round int
+}
+
+// 対戦開始時に制限時間を設定する
+func (g *Game) startClock() {
+	g.suddenDeath = 0
+	if TimeLimit <= 0 {
+		g.deadline = time.Time{}
+		return
+	}
+	g.deadline = time.Now().Add(TimeLimit)
+	g.after(TimeLimit, timeUpAction{round: g.round})
+	g.EventChannel <- g.clock()
+}
+
+// 残り時間と現在のダメージ倍率
+func (g *Game) clock() ClockEvent {
+	return ClockEvent{
+		Remaining:   time.Until(g.deadline),
+		SuddenDeath: g.suddenDeath > 0,
+		Multiplier:  g.damageScale(),
+	}
+}
+
+// サドンデスのダメージ倍率。サドンデス前は1
+func (g *Game) damageScale() int {
+	return 1 << g.suddenDeath
+}
+
+// 体力が最も多い陣営の名前。同点の陣営がある場合はfalseを返す
+// チーム戦では生き残っているメンバーの体力の合計で比べる
+func (g *Game) leader() (string, bool) {
+	health := map[string]int{}
+	names := map[string]string{}
+	for _, id := range g.PlayerID {
+		player := g.PlayerInfo[id]
+		if player.Health < 1 {
+			continue
+		}
+		side := id.String()
+		names[side] = player.Name
+		if g.Teams > 0 {
+			side = fmt.Sprintf("Team %v", player.Team)
+			names[side] = side
+		}
+		health[side] += player.Health
+	}
+
+	leader := ""
+	tied := false
+	for side, total := range health {
+		switch {
+		case leader == "" || total > health[leader]:
+			leader = side
+			tied = false
+		case total == health[leader]:
+			tied = true
+		}
+	}
+	return names[leader], !tied
+}
+
+func (action timeUpAction) Perform(game *Game) {
+	if game.State != Playing || action.round != game.round {
+		return
+	}
+
+	if winner, ok := game.leader(); ok {
+		log.Printf("time up, %v wins", winner)
+		game.finish(winner)
+		return
+	}
+
+	// 同点ならサドンデス
+	log.Println("time up, sudden death")
+	suddenDeathAction(action).Perform(game)
+}
+
+func (action suddenDeathAction) Perform(game *Game) {
+	if game.State != Playing || action.round != game.round {
+		return
+	}
+
+	if game.suddenDeath < maxSuddenDeath {
+		game.suddenDeath++
+	}
+	game.deadline = time.Now().Add(SuddenDeathInterval)
+	game.after(SuddenDeathInterval, action)
+	game.EventChannel <- game.clock()
+}
@@ -0,0 +1,44 @@
+package server
+
+import (
+	"fmt"
+
+	"github.com/yoRyuuuuu/typex/proto"
+)
+
+// 接続を受け付けるクライアントの最も古いプロトコルのバージョン
+const MinProtocolVersion = 1
+
+// サーバの設定で有効になっていて、クライアントが対応していなければならない機能
+func requiredCapabilities() []string {
+	required := []string{}
+	if PowerUpRate > 0 {
+		required = append(required, proto.PowerUpCapability)
+	}
+	if ComboTimeout > 0 {
+		required = append(required, proto.ComboCapability)
+	}
+	if TimeLimit > 0 {
+		required = append(required, proto.ClockCapability)
+	}
+	return required
+}
+
+// クライアントのバージョンと機能がこのサーバで遊べるか確かめる
+func checkClient(info *proto.ClientInfo) error {
+	version := info.GetProtocolVersion()
+	if version < MinProtocolVersion || version > proto.ProtocolVersion {
+		return incompatible(fmt.Sprintf("client protocol version %v is not supported (server %v supports %v to %v). Please update typex-client", version, proto.Version, MinProtocolVersion, proto.ProtocolVersion))
+	}
+
+	supported := make(map[string]bool)
+	for _, capability := range info.GetCapability() {
+		supported[capability] = true
+	}
+	for _, capability := range requiredCapabilities() {
+		if !supported[capability] {
+			return incompatible(fmt.Sprintf("client does not support %v, which is enabled on this server. Please update typex-client", capability))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,147 @@
+package server
+
+var JapaneseWords = []Word{
+	{Text: "寿司", Reading: "すし"},
+	{Text: "天ぷら", Reading: "てんぷら"},
+	{Text: "味噌汁", Reading: "みそしる"},
+	{Text: "抹茶", Reading: "まっちゃ"},
+	{Text: "牛乳", Reading: "ぎゅうにゅう"},
+	{Text: "珈琲", Reading: "こーひー"},
+	{Text: "紅茶", Reading: "こうちゃ"},
+	{Text: "お茶漬け", Reading: "おちゃづけ"},
+	{Text: "焼き鳥", Reading: "やきとり"},
+	{Text: "唐揚げ", Reading: "からあげ"},
+	{Text: "納豆", Reading: "なっとう"},
+	{Text: "豆腐", Reading: "とうふ"},
+	{Text: "餃子", Reading: "ぎょうざ"},
+	{Text: "饂飩", Reading: "うどん"},
+	{Text: "蕎麦", Reading: "そば"},
+	{Text: "拉麺", Reading: "らーめん"},
+	{Text: "お好み焼き", Reading: "おこのみやき"},
+	{Text: "たこ焼き", Reading: "たこやき"},
+	{Text: "弁当", Reading: "べんとう"},
+	{Text: "朝ご飯", Reading: "あさごはん"},
+	{Text: "学校", Reading: "がっこう"},
+	{Text: "先生", Reading: "せんせい"},
+	{Text: "教室", Reading: "きょうしつ"},
+	{Text: "宿題", Reading: "しゅくだい"},
+	{Text: "試験", Reading: "しけん"},
+	{Text: "図書館", Reading: "としょかん"},
+	{Text: "辞書", Reading: "じしょ"},
+	{Text: "鉛筆", Reading: "えんぴつ"},
+	{Text: "消しゴム", Reading: "けしごむ"},
+	{Text: "黒板", Reading: "こくばん"},
+	{Text: "会社", Reading: "かいしゃ"},
+	{Text: "会議", Reading: "かいぎ"},
+	{Text: "出張", Reading: "しゅっちょう"},
+	{Text: "残業", Reading: "ざんぎょう"},
+	{Text: "給料", Reading: "きゅうりょう"},
+	{Text: "名刺", Reading: "めいし"},
+	{Text: "締め切り", Reading: "しめきり"},
+	{Text: "報告書", Reading: "ほうこくしょ"},
+	{Text: "打ち合わせ", Reading: "うちあわせ"},
+	{Text: "社長", Reading: "しゃちょう"},
+	{Text: "電車", Reading: "でんしゃ"},
+	{Text: "新幹線", Reading: "しんかんせん"},
+	{Text: "飛行機", Reading: "ひこうき"},
+	{Text: "自転車", Reading: "じてんしゃ"},
+	{Text: "自動車", Reading: "じどうしゃ"},
+	{Text: "地下鉄", Reading: "ちかてつ"},
+	{Text: "切符", Reading: "きっぷ"},
+	{Text: "駅", Reading: "えき"},
+	{Text: "空港", Reading: "くうこう"},
+	{Text: "交差点", Reading: "こうさてん"},
+	{Text: "東京", Reading: "とうきょう"},
+	{Text: "大阪", Reading: "おおさか"},
+	{Text: "京都", Reading: "きょうと"},
+	{Text: "北海道", Reading: "ほっかいどう"},
+	{Text: "沖縄", Reading: "おきなわ"},
+	{Text: "富士山", Reading: "ふじさん"},
+	{Text: "温泉", Reading: "おんせん"},
+	{Text: "神社", Reading: "じんじゃ"},
+	{Text: "お寺", Reading: "おてら"},
+	{Text: "旅館", Reading: "りょかん"},
+	{Text: "桜", Reading: "さくら"},
+	{Text: "紅葉", Reading: "こうよう"},
+	{Text: "花火", Reading: "はなび"},
+	{Text: "雪だるま", Reading: "ゆきだるま"},
+	{Text: "台風", Reading: "たいふう"},
+	{Text: "地震", Reading: "じしん"},
+	{Text: "天気予報", Reading: "てんきよほう"},
+	{Text: "夕焼け", Reading: "ゆうやけ"},
+	{Text: "星空", Reading: "ほしぞら"},
+	{Text: "海岸", Reading: "かいがん"},
+	{Text: "猫", Reading: "ねこ"},
+	{Text: "犬", Reading: "いぬ"},
+	{Text: "金魚", Reading: "きんぎょ"},
+	{Text: "兎", Reading: "うさぎ"},
+	{Text: "狐", Reading: "きつね"},
+	{Text: "狸", Reading: "たぬき"},
+	{Text: "熊", Reading: "くま"},
+	{Text: "象", Reading: "ぞう"},
+	{Text: "鶏", Reading: "にわとり"},
+	{Text: "蝶々", Reading: "ちょうちょう"},
+	{Text: "携帯電話", Reading: "けいたいでんわ"},
+	{Text: "パソコン", Reading: "ぱそこん"},
+	{Text: "キーボード", Reading: "きーぼーど"},
+	{Text: "マウス", Reading: "まうす"},
+	{Text: "画面", Reading: "がめん"},
+	{Text: "写真", Reading: "しゃしん"},
+	{Text: "映画", Reading: "えいが"},
+	{Text: "音楽", Reading: "おんがく"},
+	{Text: "新聞", Reading: "しんぶん"},
+	{Text: "雑誌", Reading: "ざっし"},
+	{Text: "野球", Reading: "やきゅう"},
+	{Text: "相撲", Reading: "すもう"},
+	{Text: "柔道", Reading: "じゅうどう"},
+	{Text: "剣道", Reading: "けんどう"},
+	{Text: "水泳", Reading: "すいえい"},
+	{Text: "卓球", Reading: "たっきゅう"},
+	{Text: "将棋", Reading: "しょうぎ"},
+	{Text: "囲碁", Reading: "いご"},
+	{Text: "運動会", Reading: "うんどうかい"},
+	{Text: "誕生日", Reading: "たんじょうび"},
+	{Text: "お正月", Reading: "おしょうがつ"},
+	{Text: "年賀状", Reading: "ねんがじょう"},
+	{Text: "お土産", Reading: "おみやげ"},
+	{Text: "買い物", Reading: "かいもの"},
+	{Text: "病院", Reading: "びょういん"},
+	{Text: "郵便局", Reading: "ゆうびんきょく"},
+	{Text: "銀行", Reading: "ぎんこう"},
+	{Text: "美容院", Reading: "びよういん"},
+	{Text: "冷蔵庫", Reading: "れいぞうこ"},
+	{Text: "洗濯機", Reading: "せんたくき"},
+	{Text: "掃除機", Reading: "そうじき"},
+	{Text: "炬燵", Reading: "こたつ"},
+	{Text: "布団", Reading: "ふとん"},
+	{Text: "畳", Reading: "たたみ"},
+	{Text: "障子", Reading: "しょうじ"},
+	{Text: "風鈴", Reading: "ふうりん"},
+	{Text: "提灯", Reading: "ちょうちん"},
+	{Text: "浴衣", Reading: "ゆかた"},
+	{Text: "着物", Reading: "きもの"},
+	{Text: "忍者", Reading: "にんじゃ"},
+	{Text: "侍", Reading: "さむらい"},
+	{Text: "お城", Reading: "おしろ"},
+	{Text: "歌舞伎", Reading: "かぶき"},
+	{Text: "漫画", Reading: "まんが"},
+	{Text: "一生懸命", Reading: "いっしょうけんめい"},
+	{Text: "以心伝心", Reading: "いしんでんしん"},
+	{Text: "一期一会", Reading: "いちごいちえ"},
+	{Text: "温故知新", Reading: "おんこちしん"},
+	{Text: "七転八起", Reading: "しちてんはっき"},
+	{Text: "十人十色", Reading: "じゅうにんといろ"},
+	{Text: "こんにちは", Reading: "こんにちは"},
+	{Text: "ありがとう", Reading: "ありがとう"},
+	{Text: "おはようございます", Reading: "おはようございます"},
+	{Text: "よろしくお願いします", Reading: "よろしくおねがいします"},
+	{Text: "お疲れ様です", Reading: "おつかれさまです"},
+	{Text: "いただきます", Reading: "いただきます"},
+	{Text: "ごちそうさま", Reading: "ごちそうさま"},
+	{Text: "タイピング", Reading: "たいぴんぐ"},
+	{Text: "バトルロイヤル", Reading: "ばとるろいやる"},
+	{Text: "パーティー", Reading: "ぱーてぃー"},
+	{Text: "チョコレート", Reading: "ちょこれーと"},
+	{Text: "ファミレス", Reading: "ふぁみれす"},
+	{Text: "ヴァイオリン", Reading: "ゔぁいおりん"},
+}